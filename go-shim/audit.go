@@ -0,0 +1,143 @@
+package skillgate
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultAuditDrainInterval = 10 * time.Second
+	auditDrainBatchSize       = 100
+
+	// auditDrainCallTimeout bounds each Health check and PostAuditBatch
+	// call made while draining. It's independent of cfg.TimeoutMs, which
+	// is sized for the latency-sensitive Decide path (default 50ms) and
+	// would starve a multi-batch drain of a large backlog after a long
+	// outage.
+	auditDrainCallTimeout = 5 * time.Second
+)
+
+// auditPoster is implemented by transports that can deliver spooled audit
+// records to the sidecar. The HTTP transport and ClusterClient support this;
+// the drain loop is a no-op for any other transport (such as gRPC).
+type auditPoster interface {
+	PostAuditBatch(ctx context.Context, records []AuditRecord) error
+}
+
+// drainAuditSpool periodically checks sidecar health and, once it
+// succeeds, flushes cfg.AuditSpool to the sidecar. It runs until Close is
+// called.
+func (c *Client) drainAuditSpool() {
+	defer close(c.done)
+
+	interval := c.cfg.AuditDrainInterval
+	if interval <= 0 {
+		interval = defaultAuditDrainInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.drainAuditSpoolOnce()
+		}
+	}
+}
+
+// drainAuditSpoolOnce drains cfg.AuditSpool in batches of
+// auditDrainBatchSize until it's empty, the sidecar stops answering, or the
+// transport doesn't support audit delivery.
+func (c *Client) drainAuditSpoolOnce() {
+	poster, ok := c.transport.(auditPoster)
+	if !ok {
+		return
+	}
+
+	healthCtx, cancel := context.WithTimeout(context.Background(), auditDrainCallTimeout)
+	defer cancel()
+	if err := c.transport.Health(healthCtx); err != nil {
+		return
+	}
+
+	for {
+		records, err := c.cfg.AuditSpool.Pending(auditDrainBatchSize)
+		if err != nil || len(records) == 0 {
+			return
+		}
+
+		batchCtx, cancel := context.WithTimeout(context.Background(), auditDrainCallTimeout)
+		err = poster.PostAuditBatch(batchCtx, records)
+		cancel()
+		if err != nil {
+			return
+		}
+
+		ids := make([]string, len(records))
+		for i, r := range records {
+			ids[i] = r.InvocationID
+		}
+		if err := c.cfg.AuditSpool.Ack(ids); err != nil {
+			return
+		}
+		if len(records) < auditDrainBatchSize {
+			return
+		}
+	}
+}
+
+// spoolDegraded durably records a degraded decision via cfg.AuditSpool, if
+// configured. Spooling failures are swallowed: a degraded decision is
+// already the lowest-assurance path, and Decide must still return it to
+// the caller.
+func (c *Client) spoolDegraded(invocation ToolInvocation, decision DecisionRecord) {
+	if c.cfg.AuditSpool == nil {
+		return
+	}
+	_ = c.cfg.AuditSpool.Append(AuditRecord{
+		InvocationID: invocation.InvocationID,
+		Invocation:   invocation,
+		Decision:     decision,
+		RecordedAt:   time.Now().UTC(),
+	})
+}
+
+// cachedDecision looks up invocation in cfg.DecisionCache, if configured.
+func (c *Client) cachedDecision(invocation ToolInvocation) (DecisionRecord, bool) {
+	if c.cfg.DecisionCache == nil {
+		return DecisionRecord{}, false
+	}
+	return c.cfg.DecisionCache.Get(cacheKey(invocation))
+}
+
+// observeFreshDecision records a non-degraded decision in cfg.DecisionCache,
+// first flushing it if PolicyVersion or EntitlementVersion changed since
+// the last fresh decision this Client saw: a cached decision made under a
+// superseded policy or entitlement must never be served again.
+func (c *Client) observeFreshDecision(invocation ToolInvocation, decision DecisionRecord) {
+	c.mu.Lock()
+	versionChanged := c.lastPolicyVersion != "" &&
+		(decision.PolicyVersion != c.lastPolicyVersion || decision.EntitlementVersion != c.lastEntitlementVersion)
+	c.lastPolicyVersion = decision.PolicyVersion
+	c.lastEntitlementVersion = decision.EntitlementVersion
+	c.mu.Unlock()
+
+	if c.cfg.DecisionCache == nil {
+		return
+	}
+	if versionChanged {
+		c.cfg.DecisionCache.Flush()
+	}
+	c.cfg.DecisionCache.Put(cacheKey(invocation), decision, c.decisionCacheTTL())
+}
+
+// decisionCacheTTL returns cfg.DecisionCacheTTL, or defaultDecisionCacheTTL
+// if unset.
+func (c *Client) decisionCacheTTL() time.Duration {
+	if c.cfg.DecisionCacheTTL > 0 {
+		return c.cfg.DecisionCacheTTL
+	}
+	return defaultDecisionCacheTTL
+}
@@ -0,0 +1,377 @@
+package skillgate
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditRecord is one degraded invocation + decision, durably buffered by an
+// AuditSpool while the sidecar is unreachable or answering degraded.
+type AuditRecord struct {
+	InvocationID string         `json:"invocation_id"`
+	Invocation   ToolInvocation `json:"invocation"`
+	Decision     DecisionRecord `json:"decision"`
+	RecordedAt   time.Time      `json:"recorded_at"`
+}
+
+// AuditSpool buffers AuditRecords durably while the sidecar is unreachable
+// and exposes them for draining once it recovers. Client.Decide appends to
+// it on every degraded decision; the background goroutine started by New
+// drains it.
+type AuditSpool interface {
+	// Append durably records record. A successful return must survive a
+	// process crash.
+	Append(record AuditRecord) error
+	// Pending returns up to limit not-yet-acknowledged records, oldest
+	// first.
+	Pending(limit int) ([]AuditRecord, error)
+	// Ack marks invocationIDs as delivered. Callers must pass exactly the
+	// IDs most recently returned by Pending: delivery is at-least-once
+	// from a single sequential consumer (the drain goroutine), and the
+	// sidecar dedups by InvocationID, so anything more than that is
+	// unnecessary.
+	Ack(invocationIDs []string) error
+}
+
+const (
+	spoolSegmentBytes = 4 << 20 // rotate once a segment reaches ~4MiB
+	spoolOffsetSuffix = ".offset"
+)
+
+// spoolSegment is one append-only, newline-delimited-JSON segment file.
+type spoolSegment struct {
+	seq   int
+	path  string
+	file  *os.File // open for append
+	acked int      // records already delivered, per the offset file
+	count int      // total records appended to this segment
+}
+
+// FileAuditSpool is an AuditSpool backed by a directory of append-only,
+// segmented, newline-delimited-JSON log files. Every Append batch is
+// fsynced before returning. Disk use is bounded by MaxBytes, evicting the
+// oldest segment first, and by MaxAge, dropping segments older than that
+// regardless of ack state; the current (still being written) segment is
+// never evicted. Resumable delivery offsets are stored alongside each
+// segment in a "<segment>.offset" file, so draining resumes correctly
+// after a process restart.
+type FileAuditSpool struct {
+	Dir      string
+	MaxBytes int64
+	MaxAge   time.Duration
+
+	mu       sync.Mutex
+	segments []*spoolSegment // oldest first
+	nextSeq  int
+}
+
+// NewFileAuditSpool opens (or creates) a FileAuditSpool rooted at dir,
+// replaying any existing segments and their offset files. A maxBytes or
+// maxAge <= 0 disables that cap.
+func NewFileAuditSpool(dir string, maxBytes int64, maxAge time.Duration) (*FileAuditSpool, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("skillgate: create audit spool dir: %w", err)
+	}
+	s := &FileAuditSpool{Dir: dir, MaxBytes: maxBytes, MaxAge: maxAge}
+	if err := s.loadSegments(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// loadSegments scans Dir for existing segments, reopens each for append,
+// and drops any that are already fully acknowledged.
+func (s *FileAuditSpool) loadSegments() error {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return fmt.Errorf("skillgate: read audit spool dir: %w", err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".jsonl") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		seq, err := strconv.Atoi(strings.TrimSuffix(name, ".jsonl"))
+		if err != nil {
+			continue // not one of ours
+		}
+		path := filepath.Join(s.Dir, name)
+
+		count, err := countLines(path)
+		if err != nil {
+			return err
+		}
+		acked := readOffset(path)
+		if acked >= count {
+			os.Remove(path)
+			os.Remove(path + spoolOffsetSuffix)
+			continue
+		}
+
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("skillgate: reopen audit segment %s: %w", path, err)
+		}
+		s.segments = append(s.segments, &spoolSegment{seq: seq, path: path, file: f, acked: acked, count: count})
+		if seq >= s.nextSeq {
+			s.nextSeq = seq + 1
+		}
+	}
+	return nil
+}
+
+// Append implements AuditSpool.
+func (s *FileAuditSpool) Append(record AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("skillgate: marshal audit record: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seg, err := s.currentSegmentLocked()
+	if err != nil {
+		return err
+	}
+	if _, err := seg.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("skillgate: write audit record: %w", err)
+	}
+	if err := seg.file.Sync(); err != nil {
+		return fmt.Errorf("skillgate: fsync audit segment: %w", err)
+	}
+	seg.count++
+
+	s.enforceCapsLocked()
+	return nil
+}
+
+// currentSegmentLocked returns the writable segment, rotating to a new one
+// if there isn't one yet or the last one is full. Callers must hold s.mu.
+func (s *FileAuditSpool) currentSegmentLocked() (*spoolSegment, error) {
+	if len(s.segments) > 0 {
+		last := s.segments[len(s.segments)-1]
+		if info, err := last.file.Stat(); err == nil && info.Size() < spoolSegmentBytes {
+			return last, nil
+		}
+	}
+	return s.rotateLocked()
+}
+
+// rotateLocked creates and appends a new, empty segment. Callers must hold
+// s.mu.
+func (s *FileAuditSpool) rotateLocked() (*spoolSegment, error) {
+	seq := s.nextSeq
+	s.nextSeq++
+	path := filepath.Join(s.Dir, fmt.Sprintf("%010d.jsonl", seq))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("skillgate: create audit segment: %w", err)
+	}
+	seg := &spoolSegment{seq: seq, path: path, file: f}
+	s.segments = append(s.segments, seg)
+	return seg, nil
+}
+
+// enforceCapsLocked evicts segments older than MaxAge, then the oldest
+// segments until total size is within MaxBytes. The current (last) segment
+// is never evicted. Callers must hold s.mu.
+func (s *FileAuditSpool) enforceCapsLocked() {
+	if s.MaxAge > 0 {
+		now := time.Now()
+		kept := s.segments[:0:0]
+		for i, seg := range s.segments {
+			last := i == len(s.segments)-1
+			if info, err := os.Stat(seg.path); !last && err == nil && now.Sub(info.ModTime()) > s.MaxAge {
+				s.evictSegment(seg)
+				continue
+			}
+			kept = append(kept, seg)
+		}
+		s.segments = kept
+	}
+
+	if s.MaxBytes <= 0 {
+		return
+	}
+	for len(s.segments) > 1 && s.totalBytesLocked() > s.MaxBytes {
+		s.evictSegment(s.segments[0])
+		s.segments = s.segments[1:]
+	}
+}
+
+// totalBytesLocked sums the on-disk size of every segment. Callers must
+// hold s.mu.
+func (s *FileAuditSpool) totalBytesLocked() int64 {
+	var total int64
+	for _, seg := range s.segments {
+		if info, err := os.Stat(seg.path); err == nil {
+			total += info.Size()
+		}
+	}
+	return total
+}
+
+// evictSegment closes and deletes seg and its offset file. Callers must
+// hold s.mu.
+func (s *FileAuditSpool) evictSegment(seg *spoolSegment) {
+	seg.file.Close()
+	os.Remove(seg.path)
+	os.Remove(seg.path + spoolOffsetSuffix)
+}
+
+// Pending implements AuditSpool.
+func (s *FileAuditSpool) Pending(limit int) ([]AuditRecord, error) {
+	s.mu.Lock()
+	segs := make([]*spoolSegment, len(s.segments))
+	copy(segs, s.segments)
+	s.mu.Unlock()
+
+	var out []AuditRecord
+	for _, seg := range segs {
+		if len(out) >= limit {
+			break
+		}
+		records, err := readSegmentRecords(seg.path, seg.acked, limit-len(out))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, records...)
+	}
+	return out, nil
+}
+
+// Ack implements AuditSpool.
+func (s *FileAuditSpool) Ack(invocationIDs []string) error {
+	remaining := len(invocationIDs)
+	if remaining == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, seg := range s.segments {
+		if remaining <= 0 {
+			break
+		}
+		unacked := seg.count - seg.acked
+		if unacked <= 0 {
+			continue
+		}
+		n := unacked
+		if n > remaining {
+			n = remaining
+		}
+		seg.acked += n
+		remaining -= n
+		if err := writeOffset(seg.path, seg.acked); err != nil {
+			return err
+		}
+	}
+
+	s.pruneFullyAckedLocked()
+	return nil
+}
+
+// pruneFullyAckedLocked evicts every fully-delivered segment except the
+// current (last) one. Callers must hold s.mu.
+func (s *FileAuditSpool) pruneFullyAckedLocked() {
+	kept := s.segments[:0:0]
+	for i, seg := range s.segments {
+		if seg.acked >= seg.count && i != len(s.segments)-1 {
+			s.evictSegment(seg)
+			continue
+		}
+		kept = append(kept, seg)
+	}
+	s.segments = kept
+}
+
+// readSegmentRecords decodes up to limit records from path, skipping the
+// first skip lines.
+func readSegmentRecords(path string, skip, limit int) ([]AuditRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("skillgate: open audit segment %s: %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	var records []AuditRecord
+	i := 0
+	for scanner.Scan() {
+		if i < skip {
+			i++
+			continue
+		}
+		if len(records) >= limit {
+			break
+		}
+		var rec AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return nil, fmt.Errorf("skillgate: decode audit record in %s: %w", path, err)
+		}
+		records = append(records, rec)
+		i++
+	}
+	return records, scanner.Err()
+}
+
+// countLines counts the newline-delimited records in path.
+func countLines(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("skillgate: open audit segment %s: %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	n := 0
+	for scanner.Scan() {
+		n++
+	}
+	return n, scanner.Err()
+}
+
+// readOffset reads the ack count stored alongside segPath, or 0 if absent.
+func readOffset(segPath string) int {
+	raw, err := os.ReadFile(segPath + spoolOffsetSuffix)
+	if err != nil {
+		return 0
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// writeOffset durably persists acked for segPath, via a rename so a crash
+// mid-write can never leave a corrupt offset file.
+func writeOffset(segPath string, acked int) error {
+	tmp := segPath + spoolOffsetSuffix + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.Itoa(acked)), 0o600); err != nil {
+		return fmt.Errorf("skillgate: write audit offset: %w", err)
+	}
+	if err := os.Rename(tmp, segPath+spoolOffsetSuffix); err != nil {
+		return fmt.Errorf("skillgate: install audit offset: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package skillgate_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	skillgate "github.com/skillgate-io/skillgate-go"
+)
+
+func testAuditRecord(invocationID string) skillgate.AuditRecord {
+	return skillgate.AuditRecord{
+		InvocationID: invocationID,
+		Invocation:   testInvocation(),
+		Decision: skillgate.DecisionRecord{
+			InvocationID: invocationID,
+			Decision:     "ALLOW",
+			DecisionCode: "SG_ALLOW_DEGRADED_AUDIT_ASYNC",
+			Degraded:     true,
+		},
+		RecordedAt: time.Now().UTC(),
+	}
+}
+
+func TestFileAuditSpool_AppendPendingAck(t *testing.T) {
+	spool, err := skillgate.NewFileAuditSpool(filepath.Join(t.TempDir(), "spool"), 0, 0)
+	if err != nil {
+		t.Fatalf("NewFileAuditSpool: %v", err)
+	}
+
+	for _, id := range []string{"inv-1", "inv-2", "inv-3"} {
+		if err := spool.Append(testAuditRecord(id)); err != nil {
+			t.Fatalf("Append(%s): %v", id, err)
+		}
+	}
+
+	pending, err := spool.Pending(10)
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	if len(pending) != 3 {
+		t.Fatalf("expected 3 pending records, got %d", len(pending))
+	}
+	if pending[0].InvocationID != "inv-1" || pending[2].InvocationID != "inv-3" {
+		t.Errorf("expected records in append order, got %v", pending)
+	}
+
+	if err := spool.Ack([]string{"inv-1", "inv-2"}); err != nil {
+		t.Fatalf("Ack: %v", err)
+	}
+
+	pending, err = spool.Pending(10)
+	if err != nil {
+		t.Fatalf("Pending after ack: %v", err)
+	}
+	if len(pending) != 1 || pending[0].InvocationID != "inv-3" {
+		t.Errorf("expected only inv-3 pending, got %v", pending)
+	}
+}
+
+func TestFileAuditSpool_ResumesAfterReopen(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "spool")
+
+	spool, err := skillgate.NewFileAuditSpool(dir, 0, 0)
+	if err != nil {
+		t.Fatalf("NewFileAuditSpool: %v", err)
+	}
+	for _, id := range []string{"inv-1", "inv-2"} {
+		if err := spool.Append(testAuditRecord(id)); err != nil {
+			t.Fatalf("Append(%s): %v", id, err)
+		}
+	}
+	if err := spool.Ack([]string{"inv-1"}); err != nil {
+		t.Fatalf("Ack: %v", err)
+	}
+
+	reopened, err := skillgate.NewFileAuditSpool(dir, 0, 0)
+	if err != nil {
+		t.Fatalf("reopen NewFileAuditSpool: %v", err)
+	}
+	pending, err := reopened.Pending(10)
+	if err != nil {
+		t.Fatalf("Pending after reopen: %v", err)
+	}
+	if len(pending) != 1 || pending[0].InvocationID != "inv-2" {
+		t.Errorf("expected only inv-2 pending after reopen, got %v", pending)
+	}
+}
+
+func TestFileAuditSpool_PendingRespectsLimit(t *testing.T) {
+	spool, err := skillgate.NewFileAuditSpool(filepath.Join(t.TempDir(), "spool"), 0, 0)
+	if err != nil {
+		t.Fatalf("NewFileAuditSpool: %v", err)
+	}
+	for _, id := range []string{"inv-1", "inv-2", "inv-3"} {
+		if err := spool.Append(testAuditRecord(id)); err != nil {
+			t.Fatalf("Append(%s): %v", id, err)
+		}
+	}
+
+	pending, err := spool.Pending(2)
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	if len(pending) != 2 {
+		t.Errorf("expected limit of 2 records, got %d", len(pending))
+	}
+}
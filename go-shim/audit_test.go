@@ -0,0 +1,205 @@
+package skillgate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	skillgate "github.com/skillgate-io/skillgate-go"
+)
+
+func TestDecide_FailOpen_SpoolsDegradedDecision(t *testing.T) {
+	spool, err := skillgate.NewFileAuditSpool(filepath.Join(t.TempDir(), "spool"), 0, 0)
+	if err != nil {
+		t.Fatalf("NewFileAuditSpool: %v", err)
+	}
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = "http://127.0.0.1:19999"
+	cfg.TimeoutMs = 10
+	cfg.FailOpen = true
+	cfg.AuditSpool = spool
+	client := skillgate.New(cfg)
+	defer client.Close()
+
+	inv := testInvocation()
+	decision, err := client.Decide(context.Background(), inv)
+	if err != nil {
+		t.Fatalf("unexpected error in fail-open mode: %v", err)
+	}
+	if !decision.Degraded {
+		t.Fatal("expected degraded=true")
+	}
+
+	pending, err := spool.Pending(10)
+	if err != nil {
+		t.Fatalf("Pending: %v", err)
+	}
+	if len(pending) != 1 || pending[0].InvocationID != inv.InvocationID {
+		t.Errorf("expected the degraded decision to be spooled, got %v", pending)
+	}
+}
+
+func TestDecide_DecisionCache_ServesLastKnownDecisionWhenDown(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-cache"))
+	}))
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.DecisionCache = skillgate.NewDecisionCache(10)
+	client := skillgate.New(cfg)
+	defer client.Close()
+
+	inv := testInvocation()
+	inv.InvocationID = "inv-cache"
+	first, err := client.Decide(context.Background(), inv)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv.Close() // sidecar now unreachable
+	cfg2 := cfg
+	cfg2.TimeoutMs = 10
+	cfg2.FailOpen = true
+	clientDown := skillgate.New(cfg2)
+	defer clientDown.Close()
+
+	second, err := clientDown.Decide(context.Background(), inv)
+	if err != nil {
+		t.Fatalf("expected cached decision instead of an error: %v", err)
+	}
+	if second.Decision != first.Decision || second.DecisionCode != first.DecisionCode {
+		t.Errorf("expected cached decision %+v, got %+v", first, second)
+	}
+}
+
+func TestDecide_DecisionCache_NeverServedWhenFailClosed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-cache"))
+	}))
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.DecisionCache = skillgate.NewDecisionCache(10)
+	client := skillgate.New(cfg)
+	defer client.Close()
+
+	inv := testInvocation()
+	inv.InvocationID = "inv-cache"
+	if _, err := client.Decide(context.Background(), inv); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	srv.Close() // sidecar now unreachable
+	cfg2 := cfg
+	cfg2.TimeoutMs = 10
+	cfg2.FailOpen = false
+	clientDown := skillgate.New(cfg2)
+	defer clientDown.Close()
+
+	_, err := clientDown.Decide(context.Background(), inv)
+	if err == nil {
+		t.Fatal("expected EnforcerUnavailableError, got a cached decision under FailOpen=false")
+	}
+	if _, ok := err.(*skillgate.EnforcerUnavailableError); !ok {
+		t.Errorf("expected EnforcerUnavailableError, got %T: %v", err, err)
+	}
+}
+
+func TestDecide_DecisionCache_NeverServedOverLiveDegradedDecisionWhenFailClosed(t *testing.T) {
+	var degraded bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d := testDecision("inv-cache")
+		if degraded {
+			d["decision"] = "DENY"
+			d["decision_code"] = "SG_DENY_POLICY"
+			d["degraded"] = true
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(d)
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.DecisionCache = skillgate.NewDecisionCache(10)
+	client := skillgate.New(cfg)
+	defer client.Close()
+
+	inv := testInvocation()
+	inv.InvocationID = "inv-cache"
+	first, err := client.Decide(context.Background(), inv)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.Decision != "ALLOW" {
+		t.Fatalf("expected the first decision to be ALLOW, got %s", first.Decision)
+	}
+
+	// The sidecar is still reachable and now returns a live, degraded DENY
+	// for the same invocation. With FailOpen=false this must never be
+	// replaced by the stale cached ALLOW.
+	degraded = true
+	second, err := client.Decide(context.Background(), inv)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second.Decision != "DENY" {
+		t.Errorf("expected the live DENY to win over the cached ALLOW, got %s", second.Decision)
+	}
+}
+
+func TestDecide_DecisionCache_FlushedOnPolicyVersionChange(t *testing.T) {
+	policyVersion := "1.0.0"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d := testDecision("inv-cache")
+		d["policy_version"] = policyVersion
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(d)
+	}))
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.TimeoutMs = 10
+	// FailOpen=true, since only then does Decide consult the cache at all.
+	cfg.FailOpen = true
+	cfg.DecisionCache = skillgate.NewDecisionCache(10)
+	client := skillgate.New(cfg)
+	defer client.Close()
+
+	inv := testInvocation()
+	inv.InvocationID = "inv-cache"
+	if _, err := client.Decide(context.Background(), inv); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	other := testInvocation()
+	other.InvocationID = "inv-other"
+	other.Actor.ID = "agent-2"
+	if _, err := client.Decide(context.Background(), other); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Bumping the policy version on the next fresh decision must flush
+	// every cached entry, not just the one for the invocation that
+	// observed the bump.
+	policyVersion = "2.0.0"
+	if _, err := client.Decide(context.Background(), other); err != nil {
+		t.Fatalf("unexpected error on policy version bump: %v", err)
+	}
+
+	srv.Close() // sidecar now unreachable; a cache hit would mask this
+	decision, err := client.Decide(context.Background(), inv)
+	if err != nil {
+		t.Fatalf("unexpected error in fail-open mode: %v", err)
+	}
+	if !decision.Degraded {
+		t.Errorf("expected inv's cache entry to have been flushed by the policy version bump, got cached %+v", decision)
+	}
+}
@@ -0,0 +1,128 @@
+package skillgate
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const defaultDecisionCacheTTL = 30 * time.Second
+
+// DecisionCache is a small LRU cache of recent DecisionRecords, keyed by a
+// stable hash of the invoking Actor, Tool and Params (see cacheKey). Client
+// consults it when the sidecar is unreachable or answers degraded, so a
+// repeated identical call during an outage can be answered from the last
+// known ALLOW/DENY instead of always falling back to FailOpen.
+type DecisionCache struct {
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // front = most recently used
+}
+
+// decisionCacheEntry is the value stored in DecisionCache.order.
+type decisionCacheEntry struct {
+	key       string
+	decision  DecisionRecord
+	expiresAt time.Time
+}
+
+// NewDecisionCache creates a DecisionCache holding up to capacity entries.
+// A non-positive capacity defaults to 256.
+func NewDecisionCache(capacity int) *DecisionCache {
+	if capacity <= 0 {
+		capacity = 256
+	}
+	return &DecisionCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the decision cached under key, if present and not yet
+// expired.
+func (c *DecisionCache) Get(key string) (DecisionRecord, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return DecisionRecord{}, false
+	}
+	entry := el.Value.(*decisionCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.removeLocked(el)
+		return DecisionRecord{}, false
+	}
+	c.order.MoveToFront(el)
+	return entry.decision, true
+}
+
+// Put stores decision under key, valid for ttl, evicting the least
+// recently used entry if the cache is already at capacity.
+func (c *DecisionCache) Put(key string, decision DecisionRecord, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*decisionCacheEntry)
+		entry.decision = decision
+		entry.expiresAt = time.Now().Add(ttl)
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&decisionCacheEntry{
+		key:       key,
+		decision:  decision,
+		expiresAt: time.Now().Add(ttl),
+	})
+	c.entries[key] = el
+
+	if c.order.Len() > c.capacity {
+		c.removeLocked(c.order.Back())
+	}
+}
+
+// Flush discards every cached entry. Client calls this when PolicyVersion
+// or EntitlementVersion changes on a fresh decision.
+func (c *DecisionCache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+}
+
+// removeLocked deletes el from both the index and the LRU list. Callers
+// must hold c.mu.
+func (c *DecisionCache) removeLocked(el *list.Element) {
+	entry := el.Value.(*decisionCacheEntry)
+	delete(c.entries, entry.key)
+	c.order.Remove(el)
+}
+
+// cacheKey returns a stable hash of the fields that identify a repeatable
+// invocation: Actor.ID, Tool.Name, Request.Params and Request.ResourceRefs.
+// encoding/json sorts map keys on marshal, so the hash is independent of
+// Params iteration order.
+func cacheKey(invocation ToolInvocation) string {
+	h := sha256.New()
+	h.Write([]byte(invocation.Actor.ID))
+	h.Write([]byte{0})
+	h.Write([]byte(invocation.Tool.Name))
+	h.Write([]byte{0})
+	if params, err := json.Marshal(invocation.Request.Params); err == nil {
+		h.Write(params)
+	}
+	h.Write([]byte{0})
+	for _, ref := range invocation.Request.ResourceRefs {
+		h.Write([]byte(ref))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
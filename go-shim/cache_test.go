@@ -0,0 +1,56 @@
+package skillgate_test
+
+import (
+	"testing"
+	"time"
+
+	skillgate "github.com/skillgate-io/skillgate-go"
+)
+
+func TestDecisionCache_GetPutExpiry(t *testing.T) {
+	cache := skillgate.NewDecisionCache(10)
+
+	if _, ok := cache.Get("missing"); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	cache.Put("key", skillgate.DecisionRecord{Decision: "ALLOW"}, time.Hour)
+	got, ok := cache.Get("key")
+	if !ok || got.Decision != "ALLOW" {
+		t.Fatalf("expected cached ALLOW, got %+v ok=%v", got, ok)
+	}
+
+	cache.Put("expired", skillgate.DecisionRecord{Decision: "DENY"}, -time.Second)
+	if _, ok := cache.Get("expired"); ok {
+		t.Fatal("expected expired entry to be evicted on Get")
+	}
+}
+
+func TestDecisionCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := skillgate.NewDecisionCache(2)
+
+	cache.Put("a", skillgate.DecisionRecord{Decision: "ALLOW"}, time.Hour)
+	cache.Put("b", skillgate.DecisionRecord{Decision: "ALLOW"}, time.Hour)
+	cache.Get("a") // touch a, making b the least recently used
+	cache.Put("c", skillgate.DecisionRecord{Decision: "ALLOW"}, time.Hour)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected b to be evicted as least recently used")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Error("expected a to survive eviction")
+	}
+	if _, ok := cache.Get("c"); !ok {
+		t.Error("expected c to survive eviction")
+	}
+}
+
+func TestDecisionCache_Flush(t *testing.T) {
+	cache := skillgate.NewDecisionCache(10)
+	cache.Put("key", skillgate.DecisionRecord{Decision: "ALLOW"}, time.Hour)
+	cache.Flush()
+
+	if _, ok := cache.Get("key"); ok {
+		t.Error("expected Flush to discard all entries")
+	}
+}
@@ -0,0 +1,279 @@
+package skillgate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const defaultClusterHealthInterval = 10 * time.Second
+
+// ClusterError aggregates the per-endpoint errors seen while a ClusterClient
+// tried every sidecar in its rotation and none of them succeeded.
+type ClusterError struct {
+	Errors map[string]error
+}
+
+func (e *ClusterError) Error() string {
+	msg := "skillgate: all cluster endpoints failed:"
+	for endpoint, err := range e.Errors {
+		msg += fmt.Sprintf(" %s=%q", endpoint, err)
+	}
+	return msg
+}
+
+// Unwrap exposes the per-endpoint errors so callers can errors.Is/As into
+// any one of them.
+func (e *ClusterError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// ClusterClient is a thread-safe client for a set of SkillGate sidecar
+// replicas, modeled on etcd's httpClusterClient: calls are tried against the
+// pinned leader endpoint first and fail over to the next endpoint in
+// rotation order on transport error or non-2xx status. Endpoints that fail
+// are moved to the back of the rotation and re-probed by a background
+// Health sweep; context.Canceled and context.DeadlineExceeded are the only
+// terminal errors and abort the rotation immediately.
+type ClusterClient struct {
+	cfg            Config
+	healthInterval time.Duration
+
+	mu        sync.Mutex
+	clients   []*httpEnforcer // rotation order; clients[0] is the pinned leader
+	unhealthy map[string]bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewCluster creates a Client backed by a ClusterClient transport that
+// rotates across the given sidecar base URLs, each otherwise sharing cfg.
+// healthInterval controls how often endpoints marked unhealthy are
+// re-probed in the background; a value <= 0 uses a 10s default.
+//
+// The returned Client applies cfg.Middlewares, cfg.EvidenceVerifier, and
+// cfg.AuditSpool/cfg.DecisionCache around the cluster the same way New does
+// around a single endpoint: those are cross-cutting concerns of Client, not
+// of any one transport, and must not go silently inert just because the
+// deployment is a cluster.
+func NewCluster(cfg Config, endpoints []string, healthInterval time.Duration) (*Client, error) {
+	cc, err := newClusterClient(cfg, endpoints, healthInterval)
+	if err != nil {
+		return nil, err
+	}
+	return newClientWithTransport(cfg, cc), nil
+}
+
+// newClusterClient builds the raw ClusterClient transport that NewCluster
+// wraps in a Client.
+func newClusterClient(cfg Config, endpoints []string, healthInterval time.Duration) (*ClusterClient, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("skillgate: NewCluster requires at least one endpoint")
+	}
+	if healthInterval <= 0 {
+		healthInterval = defaultClusterHealthInterval
+	}
+
+	cc := &ClusterClient{
+		cfg:            cfg,
+		healthInterval: healthInterval,
+		unhealthy:      make(map[string]bool),
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+	for _, endpoint := range endpoints {
+		epCfg := cfg
+		epCfg.SidecarURL = endpoint
+		cc.clients = append(cc.clients, newHTTPEnforcer(epCfg))
+	}
+
+	go cc.healthSweep()
+	return cc, nil
+}
+
+// Close stops the background health sweep. It does not close any in-flight
+// requests.
+func (cc *ClusterClient) Close() {
+	close(cc.stop)
+	<-cc.done
+}
+
+func (cc *ClusterClient) healthSweep() {
+	defer close(cc.done)
+	ticker := time.NewTicker(cc.healthInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-cc.stop:
+			return
+		case <-ticker.C:
+			cc.probeUnhealthy()
+		}
+	}
+}
+
+func (cc *ClusterClient) probeUnhealthy() {
+	cc.mu.Lock()
+	candidates := make([]*httpEnforcer, 0, len(cc.clients))
+	for _, c := range cc.clients {
+		if cc.unhealthy[c.cfg.SidecarURL] {
+			candidates = append(candidates, c)
+		}
+	}
+	cc.mu.Unlock()
+
+	for _, c := range candidates {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cc.cfg.TimeoutMs)*time.Millisecond)
+		err := c.Health(ctx)
+		cancel()
+		if err == nil {
+			cc.mu.Lock()
+			delete(cc.unhealthy, c.cfg.SidecarURL)
+			cc.promoteLocked(c.cfg.SidecarURL)
+			cc.mu.Unlock()
+		}
+	}
+}
+
+// promoteLocked moves endpoint to the front of the rotation, pinning it as
+// the new leader. Callers must hold cc.mu.
+func (cc *ClusterClient) promoteLocked(endpoint string) {
+	for i, c := range cc.clients {
+		if c.cfg.SidecarURL == endpoint {
+			cc.clients = append(cc.clients[:i:i], cc.clients[i+1:]...)
+			cc.clients = append([]*httpEnforcer{c}, cc.clients...)
+			return
+		}
+	}
+}
+
+// demoteLocked moves endpoint to the back of the rotation after it fails.
+// Callers must hold cc.mu.
+func (cc *ClusterClient) demoteLocked(endpoint string) {
+	cc.unhealthy[endpoint] = true
+	for i, c := range cc.clients {
+		if c.cfg.SidecarURL == endpoint {
+			cc.clients = append(cc.clients[:i:i], cc.clients[i+1:]...)
+			cc.clients = append(cc.clients, c)
+			return
+		}
+	}
+}
+
+// rotation returns a snapshot of the current try order, leader first.
+func (cc *ClusterClient) rotation() []*httpEnforcer {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	out := make([]*httpEnforcer, len(cc.clients))
+	copy(out, cc.clients)
+	return out
+}
+
+// isTerminal reports whether err should abort the rotation rather than
+// falling through to the next endpoint.
+func isTerminal(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// Decide tries Decide against each endpoint in rotation order, failing over
+// on any non-terminal error (see isTerminal), including a non-5xx HTTP
+// status. Fail-open/fail-closed handling only applies once every endpoint
+// has been tried and failed.
+func (cc *ClusterClient) Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+	clusterErr := &ClusterError{Errors: map[string]error{}}
+	for _, c := range cc.rotation() {
+		decision, err := c.decideOnce(ctx, invocation)
+		if err == nil {
+			return decision, nil
+		}
+		if isTerminal(err) {
+			return DecisionRecord{}, err
+		}
+		clusterErr.Errors[c.cfg.SidecarURL] = err
+		cc.mu.Lock()
+		cc.demoteLocked(c.cfg.SidecarURL)
+		cc.mu.Unlock()
+	}
+
+	if cc.cfg.FailOpen {
+		return degradedAllow(invocation.InvocationID), nil
+	}
+	return DecisionRecord{}, &EnforcerUnavailableError{At: time.Now().UTC(), Cause: clusterErr}
+}
+
+// RegisterTool tries RegisterTool against each endpoint in rotation order,
+// failing over on transport error or any non-2xx status — the same
+// definition of "unhealthy" Decide uses, so the two methods agree about a
+// given replica's health — and returns false only once every endpoint has
+// failed.
+func (cc *ClusterClient) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+	for _, c := range cc.rotation() {
+		status, err := c.put(ctx, "/v1/registry/"+toolName, metadata)
+		if err != nil {
+			if isTerminal(err) {
+				return false
+			}
+			cc.mu.Lock()
+			cc.demoteLocked(c.cfg.SidecarURL)
+			cc.mu.Unlock()
+			continue
+		}
+		if status < 200 || status >= 300 {
+			cc.mu.Lock()
+			cc.demoteLocked(c.cfg.SidecarURL)
+			cc.mu.Unlock()
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// Health tries Health against each endpoint in rotation order and returns
+// nil as soon as one succeeds.
+func (cc *ClusterClient) Health(ctx context.Context) error {
+	clusterErr := &ClusterError{Errors: map[string]error{}}
+	for _, c := range cc.rotation() {
+		err := c.Health(ctx)
+		if err == nil {
+			return nil
+		}
+		if isTerminal(err) {
+			return err
+		}
+		clusterErr.Errors[c.cfg.SidecarURL] = err
+		cc.mu.Lock()
+		cc.demoteLocked(c.cfg.SidecarURL)
+		cc.mu.Unlock()
+	}
+	return clusterErr
+}
+
+// PostAuditBatch tries PostAuditBatch against each endpoint in rotation
+// order, failing over the same way Decide does. Implements auditPoster so
+// a Client backed by a ClusterClient transport drains cfg.AuditSpool like
+// any other transport instead of the drain loop silently no-oping.
+func (cc *ClusterClient) PostAuditBatch(ctx context.Context, records []AuditRecord) error {
+	clusterErr := &ClusterError{Errors: map[string]error{}}
+	for _, c := range cc.rotation() {
+		err := c.PostAuditBatch(ctx, records)
+		if err == nil {
+			return nil
+		}
+		if isTerminal(err) {
+			return err
+		}
+		clusterErr.Errors[c.cfg.SidecarURL] = err
+		cc.mu.Lock()
+		cc.demoteLocked(c.cfg.SidecarURL)
+		cc.mu.Unlock()
+	}
+	return clusterErr
+}
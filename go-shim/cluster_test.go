@@ -0,0 +1,256 @@
+package skillgate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	skillgate "github.com/skillgate-io/skillgate-go"
+)
+
+func TestClusterClient_FailsOverToHealthyEndpoint(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-001"))
+	}))
+	defer up.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.TimeoutMs = 200
+	cc, err := skillgate.NewCluster(cfg, []string{down.URL, up.URL}, time.Hour)
+	if err != nil {
+		t.Fatalf("NewCluster: %v", err)
+	}
+	defer cc.Close()
+
+	decision, err := cc.Decide(context.Background(), testInvocation())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision.Decision != "ALLOW" {
+		t.Errorf("expected ALLOW, got %s", decision.Decision)
+	}
+}
+
+func TestClusterClient_FailsOverOnNon5xxStatus(t *testing.T) {
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer bad.Close()
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-001"))
+	}))
+	defer up.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.TimeoutMs = 200
+	cc, err := skillgate.NewCluster(cfg, []string{bad.URL, up.URL}, time.Hour)
+	if err != nil {
+		t.Fatalf("NewCluster: %v", err)
+	}
+	defer cc.Close()
+
+	decision, err := cc.Decide(context.Background(), testInvocation())
+	if err != nil {
+		t.Fatalf("expected failover past the 400 to the healthy endpoint, got error: %v", err)
+	}
+	if decision.Decision != "ALLOW" {
+		t.Errorf("expected ALLOW, got %s", decision.Decision)
+	}
+}
+
+func TestClusterClient_AllEndpointsDown_FailClosed(t *testing.T) {
+	cfg := skillgate.DefaultConfig()
+	cfg.TimeoutMs = 10
+	cfg.FailOpen = false
+	cc, err := skillgate.NewCluster(cfg, []string{"http://127.0.0.1:19991", "http://127.0.0.1:19992"}, time.Hour)
+	if err != nil {
+		t.Fatalf("NewCluster: %v", err)
+	}
+	defer cc.Close()
+
+	_, err = cc.Decide(context.Background(), testInvocation())
+	if err == nil {
+		t.Fatal("expected error when every endpoint is unreachable")
+	}
+	unavailable, ok := err.(*skillgate.EnforcerUnavailableError)
+	if !ok {
+		t.Fatalf("expected EnforcerUnavailableError, got %T: %v", err, err)
+	}
+	if unavailable.Cause == nil {
+		t.Error("expected Cause to carry the per-endpoint ClusterError diagnostics")
+	}
+}
+
+func TestClusterClient_AllEndpointsDown_FailOpen(t *testing.T) {
+	cfg := skillgate.DefaultConfig()
+	cfg.TimeoutMs = 10
+	cfg.FailOpen = true
+	cc, err := skillgate.NewCluster(cfg, []string{"http://127.0.0.1:19991", "http://127.0.0.1:19992"}, time.Hour)
+	if err != nil {
+		t.Fatalf("NewCluster: %v", err)
+	}
+	defer cc.Close()
+
+	decision, err := cc.Decide(context.Background(), testInvocation())
+	if err != nil {
+		t.Fatalf("unexpected error in fail-open mode: %v", err)
+	}
+	if !decision.Degraded {
+		t.Error("expected degraded=true once every endpoint has failed")
+	}
+}
+
+func TestClusterClient_TerminalErrorAbortsRotation(t *testing.T) {
+	cfg := skillgate.DefaultConfig()
+	cfg.TimeoutMs = 1000
+	cc, err := skillgate.NewCluster(cfg, []string{"http://127.0.0.1:19993", "http://127.0.0.1:19994"}, time.Hour)
+	if err != nil {
+		t.Fatalf("NewCluster: %v", err)
+	}
+	defer cc.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = cc.Decide(ctx, testInvocation())
+	if err == nil {
+		t.Fatal("expected error for canceled context")
+	}
+}
+
+func TestNewCluster_RequiresEndpoint(t *testing.T) {
+	_, err := skillgate.NewCluster(skillgate.DefaultConfig(), nil, 0)
+	if err == nil {
+		t.Fatal("expected error for empty endpoint list")
+	}
+}
+
+func TestClusterClient_HealthSweepRepromotesRecoveredEndpoint(t *testing.T) {
+	var aUp int32
+	a := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&aUp) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == "/v1/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-001"))
+	}))
+	defer a.Close()
+
+	b := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-001"))
+	}))
+
+	cfg := skillgate.DefaultConfig()
+	cfg.TimeoutMs = 200
+	cc, err := skillgate.NewCluster(cfg, []string{a.URL, b.URL}, 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewCluster: %v", err)
+	}
+	defer cc.Close()
+
+	// a starts down, so Decide fails over to b and demotes a.
+	if _, err := cc.Decide(context.Background(), testInvocation()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Bring a back up and give the background health sweep time to probe it
+	// and re-promote it to leader.
+	atomic.StoreInt32(&aUp, 1)
+	time.Sleep(150 * time.Millisecond)
+
+	// Take b down entirely. If the sweep re-promoted a, Decide should
+	// succeed immediately against a without ever needing b.
+	b.Close()
+
+	decision, err := cc.Decide(context.Background(), testInvocation())
+	if err != nil {
+		t.Fatalf("expected the health sweep to have re-promoted the recovered endpoint, got error: %v", err)
+	}
+	if decision.Decision != "ALLOW" {
+		t.Errorf("expected ALLOW, got %s", decision.Decision)
+	}
+}
+
+func TestClusterClient_Health_FailsOverToHealthyEndpoint(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer up.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.TimeoutMs = 200
+	cc, err := skillgate.NewCluster(cfg, []string{down.URL, up.URL}, time.Hour)
+	if err != nil {
+		t.Fatalf("NewCluster: %v", err)
+	}
+	defer cc.Close()
+
+	if err := cc.Health(context.Background()); err != nil {
+		t.Fatalf("expected failover past the unreachable endpoint, got error: %v", err)
+	}
+}
+
+func TestClusterClient_RegisterTool_FailsOverOnNon2xxStatus(t *testing.T) {
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer bad.Close()
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer up.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.TimeoutMs = 200
+	cc, err := skillgate.NewCluster(cfg, []string{bad.URL, up.URL}, time.Hour)
+	if err != nil {
+		t.Fatalf("NewCluster: %v", err)
+	}
+	defer cc.Close()
+
+	if ok := cc.RegisterTool(context.Background(), "tool", map[string]any{}); !ok {
+		t.Error("expected RegisterTool to fail over past the 400 to the healthy endpoint")
+	}
+}
+
+func TestClusterClient_RegisterTool_AllEndpointsFail(t *testing.T) {
+	cfg := skillgate.DefaultConfig()
+	cfg.TimeoutMs = 10
+	cc, err := skillgate.NewCluster(cfg, []string{"http://127.0.0.1:19995", "http://127.0.0.1:19996"}, time.Hour)
+	if err != nil {
+		t.Fatalf("NewCluster: %v", err)
+	}
+	defer cc.Close()
+
+	if ok := cc.RegisterTool(context.Background(), "tool", map[string]any{}); ok {
+		t.Error("expected false when every endpoint is unreachable")
+	}
+}
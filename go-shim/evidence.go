@@ -0,0 +1,239 @@
+package skillgate
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// EvidenceVerifier checks that a DecisionRecord's Evidence is a genuine
+// signature over the record, rather than forged or simply absent.
+type EvidenceVerifier interface {
+	Verify(decision DecisionRecord) error
+}
+
+// EvidenceVerificationError is returned when a DecisionRecord's Evidence
+// fails verification. Callers must treat this the same as a DENY: the
+// decision it wraps must never be surfaced as ALLOW.
+type EvidenceVerificationError struct {
+	InvocationID string
+	Reason       string
+}
+
+func (e *EvidenceVerificationError) Error() string {
+	return fmt.Sprintf("skillgate: evidence verification failed for invocation %s: %s", e.InvocationID, e.Reason)
+}
+
+// CanonicalDecisionBytes returns the exact byte sequence whose SHA-256
+// digest DecisionEvidence.Signature signs (and whose hex-encoded digest
+// StaticKeyVerifier expects in DecisionEvidence.Hash): decision with Evidence zeroed,
+// marshaled as JSON with object keys in lexicographic order at every
+// nesting level. encoding/json already sorts map keys, so round-tripping
+// the record through map[string]any is sufficient to get that ordering
+// deterministically; callers that want to pre-compute or audit a decision's
+// hash should call this directly rather than re-deriving the rule.
+func CanonicalDecisionBytes(decision DecisionRecord) []byte {
+	decision.Evidence = DecisionEvidence{}
+
+	raw, err := json.Marshal(decision)
+	if err != nil {
+		return nil
+	}
+	var generic map[string]any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil
+	}
+	canonical, err := json.Marshal(generic)
+	if err != nil {
+		return nil
+	}
+	return canonical
+}
+
+// canonicalDigest hashes CanonicalDecisionBytes(decision) with SHA-256.
+func canonicalDigest(decision DecisionRecord) [32]byte {
+	return sha256.Sum256(CanonicalDecisionBytes(decision))
+}
+
+// verifySignature checks sig against digest under key, supporting the two
+// key types the sidecar issues evidence for.
+func verifySignature(key crypto.PublicKey, digest []byte, sig []byte) error {
+	switch pub := key.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(pub, digest, sig) {
+			return errors.New("ed25519 signature mismatch")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, digest, sig) {
+			return errors.New("ecdsa signature mismatch")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+// StaticKeyVerifier verifies DecisionEvidence against a fixed set of
+// trusted public keys, keyed by Evidence.KeyID. Both Ed25519 and
+// ECDSA-P256 keys are supported.
+type StaticKeyVerifier struct {
+	Keys map[string]crypto.PublicKey
+}
+
+// Verify implements EvidenceVerifier.
+func (v *StaticKeyVerifier) Verify(decision DecisionRecord) error {
+	fail := func(reason string) error {
+		return &EvidenceVerificationError{InvocationID: decision.InvocationID, Reason: reason}
+	}
+
+	key, ok := v.Keys[decision.Evidence.KeyID]
+	if !ok {
+		return fail(fmt.Sprintf("unknown key id %q", decision.Evidence.KeyID))
+	}
+
+	digest := canonicalDigest(decision)
+	if decision.Evidence.Hash != hex.EncodeToString(digest[:]) {
+		return fail("evidence hash does not match the canonical decision bytes")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(decision.Evidence.Signature)
+	if err != nil {
+		return fail("signature is not valid base64")
+	}
+	if err := verifySignature(key, digest[:], sig); err != nil {
+		return fail(err.Error())
+	}
+	return nil
+}
+
+// fulcioIssuerOID is the Fulcio/cosign X.509 extension carrying the OIDC
+// issuer that authenticated the certificate's subject, matching the
+// extension cosign embeds in keyless-signing leaf certificates.
+var fulcioIssuerOID = []int{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+
+// keylessBundle is the JSON payload base64-encoded into Evidence.Signature
+// in keyless mode: a leaf-first DER certificate chain plus the signature
+// over the canonical decision digest.
+type keylessBundle struct {
+	Certificates [][]byte `json:"certificates"` // DER, leaf first
+	Signature    []byte   `json:"signature"`
+}
+
+// KeylessVerifier verifies DecisionEvidence issued by a short-lived
+// certificate (Fulcio/cosign-style keyless signing) instead of a static
+// key. Evidence.Signature carries a base64 JSON keylessBundle; the
+// certificate chain must verify against Roots and the leaf's identity must
+// match ExpectedIssuer and SANPattern.
+type KeylessVerifier struct {
+	// Roots is the trusted root CA pool the certificate chain verifies
+	// against.
+	Roots *x509.CertPool
+	// ExpectedIssuer is the OIDC issuer claim the leaf certificate must
+	// carry, e.g. "https://token.actions.githubusercontent.com".
+	ExpectedIssuer string
+	// SANPattern matches the leaf certificate's URI SAN (the OIDC
+	// subject); nil disables the check.
+	SANPattern *regexp.Regexp
+}
+
+// Verify implements EvidenceVerifier.
+func (v *KeylessVerifier) Verify(decision DecisionRecord) error {
+	fail := func(reason string) error {
+		return &EvidenceVerificationError{InvocationID: decision.InvocationID, Reason: reason}
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(decision.Evidence.Signature)
+	if err != nil {
+		return fail("signature bundle is not valid base64")
+	}
+	var bundle keylessBundle
+	if err := json.Unmarshal(raw, &bundle); err != nil {
+		return fail("signature bundle is not valid JSON")
+	}
+	if len(bundle.Certificates) == 0 {
+		return fail("signature bundle has no certificates")
+	}
+
+	leaf, err := x509.ParseCertificate(bundle.Certificates[0])
+	if err != nil {
+		return fail("leaf certificate is not valid DER")
+	}
+	intermediates := x509.NewCertPool()
+	for _, der := range bundle.Certificates[1:] {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return fail("intermediate certificate is not valid DER")
+		}
+		intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         v.Roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fail(fmt.Sprintf("certificate chain does not verify: %v", err))
+	}
+
+	if v.ExpectedIssuer != "" {
+		issuer, ok := fulcioIssuer(leaf)
+		if !ok || issuer != v.ExpectedIssuer {
+			return fail(fmt.Sprintf("certificate issuer %q does not match expected %q", issuer, v.ExpectedIssuer))
+		}
+	}
+	if v.SANPattern != nil {
+		matched := false
+		for _, uri := range leaf.URIs {
+			if v.SANPattern.MatchString(uri.String()) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fail("no SAN matches the expected identity pattern")
+		}
+	}
+
+	digest := canonicalDigest(decision)
+	if err := verifySignature(leaf.PublicKey, digest[:], bundle.Signature); err != nil {
+		return fail(err.Error())
+	}
+	return nil
+}
+
+// fulcioIssuer extracts the OIDC issuer embedded in a Fulcio/cosign leaf
+// certificate, if present. The extension value is ASN.1 DER (an ASN.1
+// UTF8String), not a raw UTF-8 byte string, so it must be unmarshaled
+// rather than cast directly.
+func fulcioIssuer(cert *x509.Certificate) (string, bool) {
+	for _, ext := range cert.Extensions {
+		if ext.Id.String() == oidString(fulcioIssuerOID) {
+			var issuer string
+			if _, err := asn1.Unmarshal(ext.Value, &issuer); err != nil {
+				return "", false
+			}
+			return issuer, true
+		}
+	}
+	return "", false
+}
+
+func oidString(oid []int) string {
+	s := ""
+	for i, n := range oid {
+		if i > 0 {
+			s += "."
+		}
+		s += fmt.Sprintf("%d", n)
+	}
+	return s
+}
@@ -0,0 +1,354 @@
+package skillgate_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	skillgate "github.com/skillgate-io/skillgate-go"
+)
+
+// signedDecisionJSON builds the wire JSON for a DecisionRecord signed with
+// priv under keyID.
+func signedDecisionJSON(priv ed25519.PrivateKey, keyID, invocationID string) map[string]any {
+	decision := skillgate.DecisionRecord{
+		InvocationID:       invocationID,
+		Decision:           "ALLOW",
+		DecisionCode:       "SG_ALLOW",
+		ReasonCodes:        []string{},
+		PolicyVersion:      "1.0.0",
+		Budgets:            map[string]skillgate.BudgetStatus{},
+		EntitlementVersion: "1.0",
+		LicenseMode:        "online",
+	}
+
+	digest := sha256.Sum256(skillgate.CanonicalDecisionBytes(decision))
+	sig := ed25519.Sign(priv, digest[:])
+
+	return map[string]any{
+		"invocation_id":  decision.InvocationID,
+		"decision":       decision.Decision,
+		"decision_code":  decision.DecisionCode,
+		"reason_codes":   decision.ReasonCodes,
+		"policy_version": decision.PolicyVersion,
+		"budgets":        decision.Budgets,
+		"evidence": map[string]any{
+			"hash":      hex.EncodeToString(digest[:]),
+			"signature": base64.StdEncoding.EncodeToString(sig),
+			"key_id":    keyID,
+		},
+		"degraded":            false,
+		"entitlement_version": decision.EntitlementVersion,
+		"license_mode":        decision.LicenseMode,
+	}
+}
+
+func TestDecide_EvidenceVerifier_ValidSignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(signedDecisionJSON(priv, "key1", "inv-001"))
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.EvidenceVerifier = &skillgate.StaticKeyVerifier{Keys: map[string]crypto.PublicKey{"key1": pub}}
+	client := skillgate.New(cfg)
+
+	decision, err := client.Decide(context.Background(), testInvocation())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision.Decision != "ALLOW" {
+		t.Errorf("expected ALLOW, got %s", decision.Decision)
+	}
+}
+
+func TestDecide_EvidenceVerifier_RejectsUnknownKey(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(signedDecisionJSON(priv, "key1", "inv-002"))
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.EvidenceVerifier = &skillgate.StaticKeyVerifier{Keys: map[string]crypto.PublicKey{}}
+	client := skillgate.New(cfg)
+
+	_, err = client.Decide(context.Background(), testInvocation())
+	if err == nil {
+		t.Fatal("expected evidence verification error for unknown key id")
+	}
+	if _, ok := err.(*skillgate.EvidenceVerificationError); !ok {
+		t.Errorf("expected EvidenceVerificationError, got %T: %v", err, err)
+	}
+}
+
+func TestDecide_EvidenceVerifier_RejectsTamperedSignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := signedDecisionJSON(priv, "key1", "inv-003")
+		payload["decision_code"] = "SG_ALLOW_TAMPERED" // mutated after signing
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(payload)
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.EvidenceVerifier = &skillgate.StaticKeyVerifier{Keys: map[string]crypto.PublicKey{"key1": pub}}
+	client := skillgate.New(cfg)
+
+	_, err = client.Decide(context.Background(), testInvocation())
+	if err == nil {
+		t.Fatal("expected evidence verification error for tampered decision")
+	}
+	if _, ok := err.(*skillgate.EvidenceVerificationError); !ok {
+		t.Errorf("expected EvidenceVerificationError, got %T: %v", err, err)
+	}
+}
+
+func TestDecide_NoVerifier_SkipsVerification(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(signedDecisionJSON(priv, "unknown-key", "inv-004"))
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	client := skillgate.New(cfg)
+
+	if _, err := client.Decide(context.Background(), testInvocation()); err != nil {
+		t.Fatalf("unexpected error with no verifier configured: %v", err)
+	}
+}
+
+// issuerExtension ASN.1-encodes issuer as an ASN.1 UTF8String, matching the
+// encoding Fulcio uses for its OIDC-issuer certificate extension.
+func issuerExtension(t *testing.T, issuer string) pkix.Extension {
+	t.Helper()
+	value, err := asn1.MarshalWithParams(issuer, "utf8")
+	if err != nil {
+		t.Fatalf("marshal issuer extension: %v", err)
+	}
+	return pkix.Extension{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}, Value: value}
+}
+
+// keylessLeaf issues a self-signed code-signing certificate carrying the
+// Fulcio issuer extension and a URI SAN, returning the leaf and its private
+// key. root is returned as well so tests can build a matching trust pool.
+func keylessLeaf(t *testing.T, issuer, sanURI string) (*x509.Certificate, *ecdsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate root key: %v", err)
+	}
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test fulcio root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+	if err != nil {
+		t.Fatalf("create root cert: %v", err)
+	}
+	root, err := x509.ParseCertificate(rootDER)
+	if err != nil {
+		t.Fatalf("parse root cert: %v", err)
+	}
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate leaf key: %v", err)
+	}
+	sanURL, err := url.Parse(sanURI)
+	if err != nil {
+		t.Fatalf("parse SAN URI: %v", err)
+	}
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test fulcio leaf"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		URIs:         []*url.URL{sanURL},
+	}
+	if issuer != "" {
+		leafTemplate.ExtraExtensions = []pkix.Extension{issuerExtension(t, issuer)}
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, root, &leafKey.PublicKey, rootKey)
+	if err != nil {
+		t.Fatalf("create leaf cert: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(leafDER)
+	if err != nil {
+		t.Fatalf("parse leaf cert: %v", err)
+	}
+	return leaf, leafKey, root
+}
+
+func keylessSignedDecision(t *testing.T, leaf *x509.Certificate, leafKey *ecdsa.PrivateKey, invocationID string) map[string]any {
+	t.Helper()
+
+	decision := skillgate.DecisionRecord{
+		InvocationID:       invocationID,
+		Decision:           "ALLOW",
+		DecisionCode:       "SG_ALLOW",
+		ReasonCodes:        []string{},
+		PolicyVersion:      "1.0.0",
+		Budgets:            map[string]skillgate.BudgetStatus{},
+		EntitlementVersion: "1.0",
+		LicenseMode:        "online",
+	}
+
+	digest := sha256.Sum256(skillgate.CanonicalDecisionBytes(decision))
+	sig, err := ecdsa.SignASN1(rand.Reader, leafKey, digest[:])
+	if err != nil {
+		t.Fatalf("sign digest: %v", err)
+	}
+
+	bundle, err := json.Marshal(map[string]any{
+		"certificates": [][]byte{leaf.Raw},
+		"signature":    sig,
+	})
+	if err != nil {
+		t.Fatalf("marshal keyless bundle: %v", err)
+	}
+
+	return map[string]any{
+		"invocation_id":  decision.InvocationID,
+		"decision":       decision.Decision,
+		"decision_code":  decision.DecisionCode,
+		"reason_codes":   decision.ReasonCodes,
+		"policy_version": decision.PolicyVersion,
+		"budgets":        decision.Budgets,
+		"evidence": map[string]any{
+			"signature": base64.StdEncoding.EncodeToString(bundle),
+		},
+		"degraded":            false,
+		"entitlement_version": decision.EntitlementVersion,
+		"license_mode":        decision.LicenseMode,
+	}
+}
+
+func TestDecide_KeylessVerifier_ValidChainAndIssuer(t *testing.T) {
+	leaf, leafKey, root := keylessLeaf(t, "https://token.actions.githubusercontent.com", "https://github.com/skillgate-io/skillgate/.github/workflows/release.yml@refs/heads/main")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(keylessSignedDecision(t, leaf, leafKey, "inv-006"))
+	}))
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.EvidenceVerifier = &skillgate.KeylessVerifier{
+		Roots:          roots,
+		ExpectedIssuer: "https://token.actions.githubusercontent.com",
+		SANPattern:     regexp.MustCompile(`^https://github\.com/skillgate-io/`),
+	}
+	client := skillgate.New(cfg)
+
+	decision, err := client.Decide(context.Background(), testInvocation())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision.Decision != "ALLOW" {
+		t.Errorf("expected ALLOW, got %s", decision.Decision)
+	}
+}
+
+func TestDecide_KeylessVerifier_RejectsMismatchedIssuer(t *testing.T) {
+	leaf, leafKey, root := keylessLeaf(t, "https://accounts.google.com", "https://github.com/skillgate-io/skillgate/.github/workflows/release.yml@refs/heads/main")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(keylessSignedDecision(t, leaf, leafKey, "inv-007"))
+	}))
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.EvidenceVerifier = &skillgate.KeylessVerifier{
+		Roots:          roots,
+		ExpectedIssuer: "https://token.actions.githubusercontent.com",
+	}
+	client := skillgate.New(cfg)
+
+	_, err := client.Decide(context.Background(), testInvocation())
+	if err == nil {
+		t.Fatal("expected evidence verification error for mismatched issuer")
+	}
+	if _, ok := err.(*skillgate.EvidenceVerificationError); !ok {
+		t.Errorf("expected EvidenceVerificationError, got %T: %v", err, err)
+	}
+}
+
+func TestCanonicalDecisionBytes_StableAcrossEvidence(t *testing.T) {
+	base := skillgate.DecisionRecord{
+		InvocationID:  "inv-005",
+		Decision:      "ALLOW",
+		DecisionCode:  "SG_ALLOW",
+		ReasonCodes:   []string{},
+		PolicyVersion: "1.0.0",
+		Budgets:       map[string]skillgate.BudgetStatus{},
+	}
+	withEvidence := base
+	withEvidence.Evidence = skillgate.DecisionEvidence{Hash: "abc", Signature: "sig", KeyID: "key1"}
+
+	a := skillgate.CanonicalDecisionBytes(base)
+	b := skillgate.CanonicalDecisionBytes(withEvidence)
+	if string(a) != string(b) {
+		t.Errorf("canonical bytes should be independent of Evidence, got %q vs %q", a, b)
+	}
+}
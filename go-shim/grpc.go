@@ -0,0 +1,172 @@
+package skillgate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/skillgate-io/skillgate-go/proto"
+)
+
+// ---- gRPC transport ----------------------------------------------------------
+
+// grpcEnforcer is the gRPC implementation of enforcer. It creates one client
+// connection to the sidecar at construction and reuses it across calls; the
+// 50ms default request budget is the whole point of this transport, so
+// per-call deadlines (an interceptor) and auth (per-RPC credentials) are
+// installed once as dial options rather than re-derived on every call.
+type grpcEnforcer struct {
+	cfg  Config
+	conn *grpc.ClientConn
+	stub pb.SkillGateEnforcerClient
+
+	// dialErr is set if creating the connection failed; every call then fails fast
+	// with it instead of touching a nil conn.
+	dialErr error
+}
+
+// newGRPCEnforcer creates a grpcEnforcer for the given Config.
+func newGRPCEnforcer(cfg Config) *grpcEnforcer {
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(sltCredentials{slt: cfg.SLT}),
+		grpc.WithChainUnaryInterceptor(recoveryUnaryInterceptor, deadlineUnaryInterceptor(cfg.TimeoutMs)),
+	}
+	conn, err := grpc.NewClient(cfg.SidecarURL, opts...)
+	if err != nil {
+		return &grpcEnforcer{cfg: cfg, dialErr: fmt.Errorf("skillgate: dial sidecar: %w", err)}
+	}
+	return &grpcEnforcer{cfg: cfg, conn: conn, stub: pb.NewSkillGateEnforcerClient(conn)}
+}
+
+// sltCredentials implements credentials.PerRPCCredentials, injecting the
+// Session License Token as a bearer token on every gRPC call.
+type sltCredentials struct {
+	slt string
+}
+
+func (s sltCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if s.slt == "" {
+		return nil, nil
+	}
+	return map[string]string{"authorization": "Bearer " + s.slt}, nil
+}
+
+func (s sltCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// recoveryUnaryInterceptor converts a panic in the call path into an
+// Internal status error instead of crashing the process, mirroring the
+// sidecar-side recovery interceptor this client is paired with.
+func recoveryUnaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "skillgate: panic in gRPC call %s: %v", method, r)
+		}
+	}()
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// deadlineUnaryInterceptor bounds every call to timeoutMs, mapped from
+// Config.TimeoutMs, so a single Client.Decide can't outlive the caller's
+// request budget even if ctx carries no deadline of its own.
+func deadlineUnaryInterceptor(timeoutMs int) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if timeoutMs > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, time.Duration(timeoutMs)*time.Millisecond)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func (g *grpcEnforcer) Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+	decision, err := g.decideOnce(ctx, invocation)
+	if err != nil {
+		if g.cfg.FailOpen {
+			return degradedAllow(invocation.InvocationID), nil
+		}
+		return DecisionRecord{}, &EnforcerUnavailableError{At: time.Now().UTC()}
+	}
+	return decision, nil
+}
+
+// failOpen reports whether g is configured to degrade to a synthesized
+// ALLOW on an unreachable sidecar, for retryMiddleware to check once its
+// retries against decideOnce are exhausted.
+func (g *grpcEnforcer) failOpen() bool {
+	return g.cfg.FailOpen
+}
+
+// decideOnce performs a single, un-mediated Decide RPC: no fail-open/
+// fail-closed handling, just the raw transport error. retryMiddleware uses
+// this directly so that retries happen before fail-open/fail-closed logic
+// kicks in.
+func (g *grpcEnforcer) decideOnce(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+	if g.dialErr != nil {
+		return DecisionRecord{}, g.dialErr
+	}
+
+	toolInvocation, err := toPBInvocation(invocation)
+	if err != nil {
+		return DecisionRecord{}, err
+	}
+	req := &pb.DecideRequest{
+		InvocationId:   invocation.InvocationID,
+		ToolInvocation: toolInvocation,
+	}
+	resp, err := g.stub.Decide(ctx, req)
+	if err != nil {
+		return DecisionRecord{}, err
+	}
+	return fromPBDecisionRecord(resp), nil
+}
+
+func (g *grpcEnforcer) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+	if g.dialErr != nil {
+		return false
+	}
+	pbMetadata, err := toPBStruct(metadata)
+	if err != nil {
+		return false
+	}
+	req := &pb.RegisterToolRequest{
+		ToolName: toolName,
+		Metadata: pbMetadata,
+	}
+	resp, err := g.stub.RegisterTool(ctx, req)
+	if err != nil {
+		return false
+	}
+	return resp.GetOk()
+}
+
+func (g *grpcEnforcer) Health(ctx context.Context) error {
+	if g.dialErr != nil {
+		return g.dialErr
+	}
+	resp, err := g.stub.Health(ctx, &pb.HealthRequest{})
+	if err != nil {
+		return fmt.Errorf("skillgate: sidecar unreachable: %w", err)
+	}
+	if !resp.GetOk() {
+		return fmt.Errorf("skillgate: sidecar health check failed")
+	}
+	return nil
+}
+
+// Close closes the underlying gRPC connection. It implements
+// transportCloser so Client.Close() releases the dialed connection (and its
+// goroutines/sockets) instead of leaking it.
+func (g *grpcEnforcer) Close() {
+	if g.conn != nil {
+		g.conn.Close()
+	}
+}
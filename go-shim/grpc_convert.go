@@ -0,0 +1,101 @@
+package skillgate
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/skillgate-io/skillgate-go/proto"
+)
+
+// ---- skillgate -> pb ----------------------------------------------------------
+
+func toPBInvocation(inv ToolInvocation) (*pb.ToolInvocation, error) {
+	params, err := toPBStruct(inv.Request.Params)
+	if err != nil {
+		return nil, fmt.Errorf("skillgate: convert request params: %w", err)
+	}
+	return &pb.ToolInvocation{
+		InvocationId: inv.InvocationID,
+		Timestamp:    timestamppb.New(inv.Timestamp),
+		Actor: &pb.Actor{
+			Type:        inv.Actor.Type,
+			Id:          inv.Actor.ID,
+			WorkspaceId: inv.Actor.WorkspaceID,
+			SessionId:   inv.Actor.SessionID,
+		},
+		Agent: &pb.Agent{
+			Name:      inv.Agent.Name,
+			Version:   inv.Agent.Version,
+			Framework: inv.Agent.Framework,
+			TrustTier: inv.Agent.TrustTier,
+		},
+		Tool: &pb.Tool{
+			Name:         inv.Tool.Name,
+			Provider:     inv.Tool.Provider,
+			Capabilities: inv.Tool.Capabilities,
+			RiskClass:    inv.Tool.RiskClass,
+		},
+		Request: &pb.ToolRequest{
+			Params:       params,
+			ResourceRefs: inv.Request.ResourceRefs,
+		},
+		Context: &pb.ExecutionContext{
+			Repo:               inv.Context.Repo,
+			Environment:        inv.Context.Environment,
+			DataClassification: inv.Context.DataClassification,
+			NetworkZone:        inv.Context.NetworkZone,
+		},
+	}, nil
+}
+
+// toPBStruct converts a JSON-shaped map into a google.protobuf.Struct by way
+// of an encoding/json round trip, returning an error for the same values that would make json.Marshal fail
+// on the HTTP transport's request body (e.g. a channel, func, or NaN/Inf
+// float), so a malformed invocation is rejected identically regardless of
+// cfg.Transport instead of silently going out with empty params.
+func toPBStruct(m map[string]any) (*structpb.Struct, error) {
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var generic map[string]any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	s, err := structpb.NewStruct(generic)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ---- pb -> skillgate ----------------------------------------------------------
+
+func fromPBDecisionRecord(d *pb.DecisionRecord) DecisionRecord {
+	budgets := make(map[string]BudgetStatus, len(d.GetBudgets()))
+	for name, b := range d.GetBudgets() {
+		budgets[name] = BudgetStatus{
+			Remaining: int(b.GetRemaining()),
+			Limit:     int(b.GetLimit()),
+		}
+	}
+	return DecisionRecord{
+		InvocationID:  d.GetInvocationId(),
+		Decision:      d.GetDecision(),
+		DecisionCode:  d.GetDecisionCode(),
+		ReasonCodes:   d.GetReasonCodes(),
+		PolicyVersion: d.GetPolicyVersion(),
+		Budgets:       budgets,
+		Evidence: DecisionEvidence{
+			Hash:      d.GetEvidence().GetHash(),
+			Signature: d.GetEvidence().GetSignature(),
+			KeyID:     d.GetEvidence().GetKeyId(),
+		},
+		Degraded:           d.GetDegraded(),
+		EntitlementVersion: d.GetEntitlementVersion(),
+		LicenseMode:        d.GetLicenseMode(),
+	}
+}
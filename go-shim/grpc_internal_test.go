@@ -0,0 +1,29 @@
+package skillgate
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRecoveryUnaryInterceptor_ConvertsPanicToInternalError exercises
+// recoveryUnaryInterceptor directly: unlike a server-side handler panic
+// (which grpc-go does not recover from on its own and would crash the
+// process), this interceptor guards the client's own call path, so it's
+// tested in-process against a panicking invoker rather than over the wire.
+func TestRecoveryUnaryInterceptor_ConvertsPanicToInternalError(t *testing.T) {
+	panicInvoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		panic("boom")
+	}
+
+	err := recoveryUnaryInterceptor(context.Background(), "/skillgate.v1.SkillGateEnforcer/Decide", nil, nil, nil, panicInvoker)
+	if err == nil {
+		t.Fatal("expected an error instead of a propagated panic")
+	}
+	if status.Code(err) != codes.Internal {
+		t.Errorf("expected codes.Internal, got %v", status.Code(err))
+	}
+}
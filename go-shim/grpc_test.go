@@ -0,0 +1,173 @@
+package skillgate_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	skillgate "github.com/skillgate-io/skillgate-go"
+	pb "github.com/skillgate-io/skillgate-go/proto"
+)
+
+func TestDefaultConfig_TransportIsHTTP(t *testing.T) {
+	cfg := skillgate.DefaultConfig()
+	if cfg.Transport != skillgate.TransportHTTP {
+		t.Errorf("expected TransportHTTP, got %q", cfg.Transport)
+	}
+}
+
+func TestNew_GRPCTransportUnreachable_FailsClosed(t *testing.T) {
+	cfg := skillgate.DefaultConfig()
+	cfg.Transport = skillgate.TransportGRPC
+	cfg.SidecarURL = "127.0.0.1:19995"
+	cfg.TimeoutMs = 50
+	cfg.FailOpen = false
+	client := skillgate.New(cfg)
+
+	if err := client.Health(context.Background()); err == nil {
+		t.Fatal("expected error for unreachable gRPC sidecar")
+	}
+}
+
+func TestDecide_GRPCTransport_RejectsUnconvertibleParams(t *testing.T) {
+	cfg := skillgate.DefaultConfig()
+	cfg.Transport = skillgate.TransportGRPC
+	cfg.SidecarURL = "127.0.0.1:19995"
+	cfg.TimeoutMs = 50
+	cfg.FailOpen = false
+	client := skillgate.New(cfg)
+
+	inv := testInvocation()
+	// chan values can't round-trip through google.protobuf.Struct, the same
+	// as they can't round-trip through json.Marshal on the HTTP transport;
+	// both transports must reject the invocation instead of one silently
+	// sending it with empty params.
+	inv.Request.Params = map[string]any{"callback": make(chan int)}
+
+	if _, err := client.Decide(context.Background(), inv); err == nil {
+		t.Fatal("expected an error for a param that cannot convert to google.protobuf.Struct")
+	}
+}
+
+// fakeEnforcerServer is a minimal, in-process pb.SkillGateEnforcerServer
+// that lets these tests drive the gRPC transport to a real success path,
+// the same way cluster_test.go and skillgate_test.go drive the HTTP
+// transport against an httptest.Server rather than only against
+// unreachable addresses.
+type fakeEnforcerServer struct {
+	pb.UnimplementedSkillGateEnforcerServer
+
+	gotAuth string
+}
+
+func (f *fakeEnforcerServer) Decide(ctx context.Context, req *pb.DecideRequest) (*pb.DecisionRecord, error) {
+	f.gotAuth = authFromIncomingContext(ctx)
+	return &pb.DecisionRecord{
+		InvocationId:  req.GetInvocationId(),
+		Decision:      "ALLOW",
+		DecisionCode:  "SG_ALLOW",
+		PolicyVersion: "1.0.0",
+		Evidence:      &pb.DecisionEvidence{Hash: "abc", Signature: "sig", KeyId: "key1"},
+	}, nil
+}
+
+func (f *fakeEnforcerServer) RegisterTool(ctx context.Context, req *pb.RegisterToolRequest) (*pb.RegisterToolResponse, error) {
+	return &pb.RegisterToolResponse{Ok: req.GetToolName() == "my-tool"}, nil
+}
+
+func (f *fakeEnforcerServer) Health(ctx context.Context, req *pb.HealthRequest) (*pb.HealthResponse, error) {
+	return &pb.HealthResponse{Ok: true}, nil
+}
+
+// authFromIncomingContext reads the "authorization" metadata that
+// sltCredentials attaches as PerRPCCredentials, so tests can assert on the
+// bearer token the same way skillgate_test.go asserts on the HTTP
+// transport's Authorization header.
+func authFromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
+
+// startFakeEnforcer starts fake on a real loopback listener (rather than
+// bufconn) since grpcEnforcer dials cfg.SidecarURL directly and doesn't
+// accept an injected dialer; it returns the listen address to use as
+// cfg.SidecarURL and stops the server on test cleanup.
+func startFakeEnforcer(t *testing.T, fake *fakeEnforcerServer) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterSkillGateEnforcerServer(s, fake)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+func TestDecide_GRPCTransport_Success(t *testing.T) {
+	fake := &fakeEnforcerServer{}
+	addr := startFakeEnforcer(t, fake)
+
+	cfg := skillgate.DefaultConfig()
+	cfg.Transport = skillgate.TransportGRPC
+	cfg.SidecarURL = addr
+	cfg.TimeoutMs = 1000
+	cfg.SLT = "test-slt-token"
+	client := skillgate.New(cfg)
+	defer client.Close()
+
+	decision, err := client.Decide(context.Background(), testInvocation())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision.Decision != "ALLOW" {
+		t.Errorf("expected ALLOW, got %s", decision.Decision)
+	}
+	if decision.DecisionCode != "SG_ALLOW" {
+		t.Errorf("expected SG_ALLOW, got %s", decision.DecisionCode)
+	}
+	if fake.gotAuth != "Bearer test-slt-token" {
+		t.Errorf("expected 'Bearer test-slt-token', got %q", fake.gotAuth)
+	}
+}
+
+func TestRegisterTool_GRPCTransport_Success(t *testing.T) {
+	addr := startFakeEnforcer(t, &fakeEnforcerServer{})
+
+	cfg := skillgate.DefaultConfig()
+	cfg.Transport = skillgate.TransportGRPC
+	cfg.SidecarURL = addr
+	cfg.TimeoutMs = 1000
+	client := skillgate.New(cfg)
+	defer client.Close()
+
+	if ok := client.RegisterTool(context.Background(), "my-tool", map[string]any{"version": "1.0"}); !ok {
+		t.Error("expected RegisterTool to return true")
+	}
+}
+
+func TestHealth_GRPCTransport_Success(t *testing.T) {
+	addr := startFakeEnforcer(t, &fakeEnforcerServer{})
+
+	cfg := skillgate.DefaultConfig()
+	cfg.Transport = skillgate.TransportGRPC
+	cfg.SidecarURL = addr
+	cfg.TimeoutMs = 1000
+	client := skillgate.New(cfg)
+	defer client.Close()
+
+	if err := client.Health(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,285 @@
+package skillgate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RoundTrip is the transport-agnostic unit a Middleware wraps: the same
+// decide/register/health shape enforcer exposes, so a chain composes
+// uniformly whether cfg.Transport is TransportHTTP or TransportGRPC.
+type RoundTrip = enforcer
+
+// Middleware wraps a RoundTrip with cross-cutting behavior (observability,
+// retries, redaction, ...) around every sidecar call. Middlewares run in the
+// order they appear in Config.Middlewares: the first middleware is
+// outermost and sees the call before any other.
+type Middleware func(next RoundTrip) RoundTrip
+
+// chainMiddleware wraps terminal with mws in order, so mws[0] is outermost.
+func chainMiddleware(terminal RoundTrip, mws []Middleware) RoundTrip {
+	wrapped := terminal
+	for i := len(mws) - 1; i >= 0; i-- {
+		wrapped = mws[i](wrapped)
+	}
+	return wrapped
+}
+
+// ---- Panic recovery -----------------------------------------------------
+
+// recoveryMiddleware converts a panic anywhere in the wrapped chain into an
+// error (or false, for RegisterTool) instead of crashing the process,
+// mirroring the gRPC transport's recoveryUnaryInterceptor.
+type recoveryMiddleware struct {
+	next RoundTrip
+}
+
+// RecoveryMiddleware recovers from panics raised by inner middlewares or the
+// underlying transport, turning them into ordinary error returns.
+func RecoveryMiddleware() Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return &recoveryMiddleware{next: next}
+	}
+}
+
+func (m *recoveryMiddleware) Decide(ctx context.Context, invocation ToolInvocation) (decision DecisionRecord, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("skillgate: panic in Decide: %v", r)
+		}
+	}()
+	return m.next.Decide(ctx, invocation)
+}
+
+func (m *recoveryMiddleware) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	return m.next.RegisterTool(ctx, toolName, metadata)
+}
+
+func (m *recoveryMiddleware) Health(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("skillgate: panic in Health: %v", r)
+		}
+	}()
+	return m.next.Health(ctx)
+}
+
+// ---- Redaction ------------------------------------------------------------
+
+// redactedValue replaces a scrubbed ToolRequest.Params value on its way to
+// the sidecar.
+const redactedValue = "[REDACTED]"
+
+// redactionMiddleware scrubs ToolRequest.Params keys matching pattern from
+// the invocation it passes down the chain to the sidecar's decide call. It
+// never mutates the caller's invocation: Decide copies Params before
+// redacting.
+type redactionMiddleware struct {
+	next    RoundTrip
+	pattern *regexp.Regexp
+}
+
+// RedactionMiddleware scrubs ToolRequest.Params entries whose key matches
+// pattern, replacing the value with "[REDACTED]" before the invocation is
+// sent to the sidecar. Use this to keep secrets (API keys, tokens) that a
+// tool call happens to carry in Params out of sidecar logs and evidence.
+// Only the decide call is redacted: the invocation Client.Decide records
+// in Config.AuditSpool, which the drain loop later posts to the sidecar's
+// /v1/audit/batch endpoint, carries the original, unredacted Params.
+func RedactionMiddleware(pattern *regexp.Regexp) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return &redactionMiddleware{next: next, pattern: pattern}
+	}
+}
+
+func (m *redactionMiddleware) Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+	if m.pattern != nil && len(invocation.Request.Params) > 0 {
+		scrubbed := make(map[string]any, len(invocation.Request.Params))
+		for k, v := range invocation.Request.Params {
+			if m.pattern.MatchString(k) {
+				v = redactedValue
+			}
+			scrubbed[k] = v
+		}
+		invocation.Request.Params = scrubbed
+	}
+	return m.next.Decide(ctx, invocation)
+}
+
+func (m *redactionMiddleware) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+	return m.next.RegisterTool(ctx, toolName, metadata)
+}
+
+func (m *redactionMiddleware) Health(ctx context.Context) error {
+	return m.next.Health(ctx)
+}
+
+// ---- Retry with jittered backoff ------------------------------------------
+
+const (
+	defaultRetryBaseDelay = 20 * time.Millisecond
+	defaultRetryMaxDelay  = 500 * time.Millisecond
+)
+
+// rawDecider is implemented by the terminal transports (httpEnforcer,
+// grpcEnforcer). decideOnce performs a single Decide round trip with no
+// fail-open/fail-closed conversion, and failOpen reports which way to
+// convert once retries are exhausted, so retryMiddleware can judge each
+// attempt's raw error before the transport would otherwise paper over it
+// with a degraded ALLOW.
+type rawDecider interface {
+	decideOnce(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error)
+	failOpen() bool
+}
+
+// retryMiddleware retries Decide and Health on transport errors and 5xx
+// responses, with exponential backoff and full jitter, bounded by ctx's
+// remaining deadline. RegisterTool is passed through unchanged: enforcer
+// reports it as a bool with no error to key a retry decision on. The HTTP
+// transport's Health reports any non-200 status as an untyped error, so
+// Health is retried on every non-200 status, not only on 5xx.
+type retryMiddleware struct {
+	next        RoundTrip
+	maxAttempts int
+}
+
+// RetryMiddleware retries a failed Decide or Health call up to maxAttempts
+// times (including the first try), backing off with jitter between
+// attempts. A retry is never attempted once ctx's deadline has passed, and
+// context.Canceled/context.DeadlineExceeded are never retried.
+//
+// RetryMiddleware should be the innermost middleware (last in
+// Config.Middlewares, closest to the transport): when it wraps a transport
+// directly, retries run against decideOnce, the transport's un-mediated
+// call, so fail-open/fail-closed conversion is applied at most once, after
+// retries are exhausted, instead of on the very first failure. Wrapping
+// anything other than a transport falls back to retrying the wrapped
+// RoundTrip's Decide as-is.
+func RetryMiddleware(maxAttempts int) Middleware {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return func(next RoundTrip) RoundTrip {
+		return &retryMiddleware{next: next, maxAttempts: maxAttempts}
+	}
+}
+
+// isRetryableTransportError reports whether err is a transport failure or a
+// 5xx response — the only conditions worth retrying against the *same*
+// endpoint. Unlike cluster.go's rotation (which fails over to a different
+// endpoint on any non-terminal error per isTerminal, and so can afford to be
+// lenient), a non-5xx HTTP status, a malformed-response decode error, or a
+// gRPC status reporting a request-shaped problem (e.g. InvalidArgument)
+// will fail identically on every attempt against the same sidecar, so
+// retrying it would just burn the request's deadline.
+func isRetryableTransportError(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500
+	}
+	var decodeErr *responseDecodeError
+	if errors.As(err, &decodeErr) {
+		return false
+	}
+	if code := status.Code(err); code != codes.Unknown {
+		switch code {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Aborted:
+			return true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// backoff sleeps for a jittered exponential delay before retry attempt,
+// returning false without sleeping if ctx is done or would expire first.
+func backoff(ctx context.Context, attempt int) bool {
+	delay := defaultRetryBaseDelay * time.Duration(1<<uint(attempt))
+	if delay > defaultRetryMaxDelay {
+		delay = defaultRetryMaxDelay
+	}
+	delay = time.Duration(rand.Int63n(int64(delay) + 1)) // full jitter
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return false
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(delay):
+		return true
+	}
+}
+
+func (m *retryMiddleware) Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+	raw, ok := m.next.(rawDecider)
+	if !ok {
+		var decision DecisionRecord
+		var err error
+		for attempt := 0; attempt < m.maxAttempts; attempt++ {
+			decision, err = m.next.Decide(ctx, invocation)
+			if err == nil || isTerminal(err) || !isRetryableTransportError(err) {
+				return decision, err
+			}
+			if attempt == m.maxAttempts-1 || !backoff(ctx, attempt) {
+				return decision, err
+			}
+		}
+		return decision, err
+	}
+
+	var decision DecisionRecord
+	var err error
+	for attempt := 0; attempt < m.maxAttempts; attempt++ {
+		decision, err = raw.decideOnce(ctx, invocation)
+		if err == nil || isTerminal(err) {
+			return decision, err
+		}
+		if !isRetryableTransportError(err) {
+			break // a deterministic failure (4xx, decode error, ...) will fail identically on retry
+		}
+		if attempt == m.maxAttempts-1 || !backoff(ctx, attempt) {
+			break
+		}
+	}
+
+	// Every retry against the raw transport failed: apply fail-open/
+	// fail-closed handling exactly once, now that retries are exhausted,
+	// instead of letting the transport apply it on the first failure and
+	// short-circuit the retry loop before it starts.
+	if raw.failOpen() {
+		return degradedAllow(invocation.InvocationID), nil
+	}
+	return DecisionRecord{}, &EnforcerUnavailableError{At: time.Now().UTC()}
+}
+
+func (m *retryMiddleware) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+	return m.next.RegisterTool(ctx, toolName, metadata)
+}
+
+func (m *retryMiddleware) Health(ctx context.Context) error {
+	var err error
+	for attempt := 0; attempt < m.maxAttempts; attempt++ {
+		err = m.next.Health(ctx)
+		if err == nil || isTerminal(err) || !isRetryableTransportError(err) {
+			return err
+		}
+		if attempt == m.maxAttempts-1 || !backoff(ctx, attempt) {
+			return err
+		}
+	}
+	return err
+}
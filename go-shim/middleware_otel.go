@@ -0,0 +1,88 @@
+package skillgate
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies this client to OTel exporters/backends.
+const instrumentationName = "github.com/skillgate-io/skillgate-go"
+
+// otelMiddleware emits a span and metrics for every Decide call.
+type otelMiddleware struct {
+	next      RoundTrip
+	tracer    trace.Tracer
+	latency   metric.Float64Histogram
+	decisions metric.Int64Counter
+}
+
+// OTelMiddleware wraps Decide with an OpenTelemetry span named
+// "skillgate.decide" (carrying skillgate.decision, skillgate.decision_code
+// and skillgate.degraded attributes, and recording the error if any) plus a
+// skillgate.decide.duration histogram and skillgate.decide.count counter
+// sharing the same attributes. A nil tp or mp falls back to the global
+// OTel provider.
+func OTelMiddleware(tp trace.TracerProvider, mp metric.MeterProvider) Middleware {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+	tracer := tp.Tracer(instrumentationName)
+	meter := mp.Meter(instrumentationName)
+
+	latency, _ := meter.Float64Histogram(
+		"skillgate.decide.duration",
+		metric.WithDescription("Duration of Client.Decide calls, in milliseconds."),
+		metric.WithUnit("ms"),
+	)
+	decisions, _ := meter.Int64Counter(
+		"skillgate.decide.count",
+		metric.WithDescription("Number of Client.Decide calls, by outcome."),
+	)
+
+	return func(next RoundTrip) RoundTrip {
+		return &otelMiddleware{next: next, tracer: tracer, latency: latency, decisions: decisions}
+	}
+}
+
+func (m *otelMiddleware) Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+	ctx, span := m.tracer.Start(ctx, "skillgate.decide")
+	defer span.End()
+
+	start := time.Now()
+	decision, err := m.next.Decide(ctx, invocation)
+	elapsed := time.Since(start)
+
+	attrs := []attribute.KeyValue{
+		attribute.String("skillgate.decision", decision.Decision),
+		attribute.String("skillgate.decision_code", decision.DecisionCode),
+		attribute.Bool("skillgate.degraded", decision.Degraded),
+	}
+	span.SetAttributes(attrs...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	opt := metric.WithAttributes(attrs...)
+	m.latency.Record(ctx, float64(elapsed.Milliseconds()), opt)
+	m.decisions.Add(ctx, 1, opt)
+
+	return decision, err
+}
+
+func (m *otelMiddleware) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+	return m.next.RegisterTool(ctx, toolName, metadata)
+}
+
+func (m *otelMiddleware) Health(ctx context.Context) error {
+	return m.next.Health(ctx)
+}
@@ -0,0 +1,78 @@
+package skillgate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	skillgate "github.com/skillgate-io/skillgate-go"
+)
+
+func TestOTelMiddleware_RecordsSpanAttributesAndMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-001"))
+	}))
+	defer srv.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.Middlewares = []skillgate.Middleware{skillgate.OTelMiddleware(tp, mp)}
+	client := skillgate.New(cfg)
+
+	if _, err := client.Decide(context.Background(), testInvocation()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "skillgate.decide" {
+		t.Errorf("expected span name skillgate.decide, got %s", span.Name())
+	}
+	attrs := map[string]string{}
+	for _, kv := range span.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+	}
+	if attrs["skillgate.decision"] != "ALLOW" {
+		t.Errorf("expected skillgate.decision=ALLOW, got %v", attrs["skillgate.decision"])
+	}
+	if attrs["skillgate.decision_code"] != "SG_ALLOW" {
+		t.Errorf("expected skillgate.decision_code=SG_ALLOW, got %v", attrs["skillgate.decision_code"])
+	}
+	if attrs["skillgate.degraded"] != "false" {
+		t.Errorf("expected skillgate.degraded=false, got %v", attrs["skillgate.degraded"])
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	seen := map[string]bool{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			seen[m.Name] = true
+		}
+	}
+	if !seen["skillgate.decide.count"] {
+		t.Error("expected skillgate.decide.count to have been recorded")
+	}
+	if !seen["skillgate.decide.duration"] {
+		t.Error("expected skillgate.decide.duration to have been recorded")
+	}
+}
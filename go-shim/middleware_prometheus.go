@@ -0,0 +1,61 @@
+package skillgate
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// prometheusMiddleware records decide latency and outcome counts.
+type prometheusMiddleware struct {
+	next     RoundTrip
+	duration *prometheus.HistogramVec
+	total    *prometheus.CounterVec
+}
+
+// PrometheusMiddleware wraps Decide with two metrics registered against
+// reg: skillgate_decide_duration_seconds (histogram, labeled by decision
+// and degraded) and skillgate_decide_total (counter, labeled by decision,
+// decision_code and degraded). A nil reg skips registration: the metrics
+// are still updated but are not exposed through any registry.
+func PrometheusMiddleware(reg prometheus.Registerer) Middleware {
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "skillgate_decide_duration_seconds",
+		Help:    "Duration of Client.Decide calls.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"decision", "degraded"})
+	total := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "skillgate_decide_total",
+		Help: "Number of Client.Decide calls, by outcome.",
+	}, []string{"decision", "decision_code", "degraded"})
+
+	if reg != nil {
+		reg.MustRegister(duration, total)
+	}
+
+	return func(next RoundTrip) RoundTrip {
+		return &prometheusMiddleware{next: next, duration: duration, total: total}
+	}
+}
+
+func (m *prometheusMiddleware) Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+	start := time.Now()
+	decision, err := m.next.Decide(ctx, invocation)
+	elapsed := time.Since(start)
+
+	degraded := strconv.FormatBool(decision.Degraded)
+	m.duration.WithLabelValues(decision.Decision, degraded).Observe(elapsed.Seconds())
+	m.total.WithLabelValues(decision.Decision, decision.DecisionCode, degraded).Inc()
+
+	return decision, err
+}
+
+func (m *prometheusMiddleware) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+	return m.next.RegisterTool(ctx, toolName, metadata)
+}
+
+func (m *prometheusMiddleware) Health(ctx context.Context) error {
+	return m.next.Health(ctx)
+}
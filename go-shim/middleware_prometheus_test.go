@@ -0,0 +1,81 @@
+package skillgate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	skillgate "github.com/skillgate-io/skillgate-go"
+)
+
+func TestPrometheusMiddleware_RecordsLabeledMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-001"))
+	}))
+	defer srv.Close()
+
+	reg := prometheus.NewRegistry()
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.Middlewares = []skillgate.Middleware{skillgate.PrometheusMiddleware(reg)}
+	client := skillgate.New(cfg)
+
+	if _, err := client.Decide(context.Background(), testInvocation()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics: %v", err)
+	}
+
+	var sawTotal, sawDuration bool
+	for _, family := range families {
+		switch family.GetName() {
+		case "skillgate_decide_total":
+			sawTotal = true
+			if len(family.Metric) != 1 {
+				t.Fatalf("expected 1 skillgate_decide_total series, got %d", len(family.Metric))
+			}
+			labels := labelMap(family.Metric[0].Label)
+			if labels["decision"] != "ALLOW" || labels["decision_code"] != "SG_ALLOW" || labels["degraded"] != "false" {
+				t.Errorf("unexpected labels on skillgate_decide_total: %v", labels)
+			}
+			if family.Metric[0].GetCounter().GetValue() != 1 {
+				t.Errorf("expected count 1, got %v", family.Metric[0].GetCounter().GetValue())
+			}
+		case "skillgate_decide_duration_seconds":
+			sawDuration = true
+			if len(family.Metric) != 1 {
+				t.Fatalf("expected 1 skillgate_decide_duration_seconds series, got %d", len(family.Metric))
+			}
+			labels := labelMap(family.Metric[0].Label)
+			if labels["decision"] != "ALLOW" || labels["degraded"] != "false" {
+				t.Errorf("unexpected labels on skillgate_decide_duration_seconds: %v", labels)
+			}
+			if family.Metric[0].GetHistogram().GetSampleCount() != 1 {
+				t.Errorf("expected sample count 1, got %v", family.Metric[0].GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	if !sawTotal {
+		t.Error("expected skillgate_decide_total to be registered")
+	}
+	if !sawDuration {
+		t.Error("expected skillgate_decide_duration_seconds to be registered")
+	}
+}
+
+func labelMap(pairs []*dto.LabelPair) map[string]string {
+	m := make(map[string]string, len(pairs))
+	for _, p := range pairs {
+		m[p.GetName()] = p.GetValue()
+	}
+	return m
+}
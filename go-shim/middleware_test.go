@@ -0,0 +1,233 @@
+package skillgate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	skillgate "github.com/skillgate-io/skillgate-go"
+)
+
+// orderRecorder is a Middleware that appends name to order on every Decide,
+// letting tests assert chain ordering.
+func orderRecorder(order *[]string, name string) skillgate.Middleware {
+	return func(next skillgate.RoundTrip) skillgate.RoundTrip {
+		return recorderEnforcer{next: next, order: order, name: name}
+	}
+}
+
+type recorderEnforcer struct {
+	next  skillgate.RoundTrip
+	order *[]string
+	name  string
+}
+
+func (r recorderEnforcer) Decide(ctx context.Context, invocation skillgate.ToolInvocation) (skillgate.DecisionRecord, error) {
+	*r.order = append(*r.order, r.name)
+	return r.next.Decide(ctx, invocation)
+}
+
+func (r recorderEnforcer) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+	return r.next.RegisterTool(ctx, toolName, metadata)
+}
+
+func (r recorderEnforcer) Health(ctx context.Context) error {
+	return r.next.Health(ctx)
+}
+
+func TestMiddlewares_RunOutermostFirst(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-001"))
+	}))
+	defer srv.Close()
+
+	var order []string
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.Middlewares = []skillgate.Middleware{
+		orderRecorder(&order, "outer"),
+		orderRecorder(&order, "inner"),
+	}
+	client := skillgate.New(cfg)
+
+	if _, err := client.Decide(context.Background(), testInvocation()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(order) != 2 || order[0] != "outer" || order[1] != "inner" {
+		t.Errorf("expected [outer inner], got %v", order)
+	}
+}
+
+func TestRecoveryMiddleware_ConvertsPanicToError(t *testing.T) {
+	cfg := skillgate.DefaultConfig()
+	cfg.Middlewares = []skillgate.Middleware{
+		skillgate.RecoveryMiddleware(),
+		func(next skillgate.RoundTrip) skillgate.RoundTrip { return panickingEnforcer{} },
+	}
+	client := skillgate.New(cfg)
+
+	_, err := client.Decide(context.Background(), testInvocation())
+	if err == nil {
+		t.Fatal("expected error recovered from panic")
+	}
+}
+
+type panickingEnforcer struct{}
+
+func (panickingEnforcer) Decide(ctx context.Context, invocation skillgate.ToolInvocation) (skillgate.DecisionRecord, error) {
+	panic("boom")
+}
+
+func (panickingEnforcer) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+	panic("boom")
+}
+
+func (panickingEnforcer) Health(ctx context.Context) error {
+	panic("boom")
+}
+
+func TestRedactionMiddleware_ScrubsMatchingParams(t *testing.T) {
+	var gotParams map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ToolInvocation skillgate.ToolInvocation `json:"tool_invocation"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		gotParams = body.ToolInvocation.Request.Params
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-001"))
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.Middlewares = []skillgate.Middleware{
+		skillgate.RedactionMiddleware(regexp.MustCompile(`(?i)token|secret`)),
+	}
+	client := skillgate.New(cfg)
+
+	inv := testInvocation()
+	inv.Request.Params = map[string]any{"path": "/etc/hosts", "api_token": "sk-live-123"}
+
+	if _, err := client.Decide(context.Background(), inv); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotParams["path"] != "/etc/hosts" {
+		t.Errorf("expected path to pass through unredacted, got %v", gotParams["path"])
+	}
+	if gotParams["api_token"] != "[REDACTED]" {
+		t.Errorf("expected api_token to be redacted, got %v", gotParams["api_token"])
+	}
+	if inv.Request.Params["api_token"] != "sk-live-123" {
+		t.Error("RedactionMiddleware must not mutate the caller's invocation")
+	}
+}
+
+func TestRetryMiddleware_RetriesOn5xxThenSucceeds(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(testDecision("inv-001"))
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.TimeoutMs = 2000
+	cfg.Middlewares = []skillgate.Middleware{skillgate.RetryMiddleware(3)}
+	client := skillgate.New(cfg)
+
+	decision, err := client.Decide(context.Background(), testInvocation())
+	if err != nil {
+		t.Fatalf("unexpected error after retries: %v", err)
+	}
+	if decision.Decision != "ALLOW" {
+		t.Errorf("expected ALLOW, got %s", decision.Decision)
+	}
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryMiddleware_RetriesBeforeFailOpenApplies(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.TimeoutMs = 2000
+	cfg.FailOpen = true
+	cfg.Middlewares = []skillgate.Middleware{skillgate.RetryMiddleware(3)}
+	client := skillgate.New(cfg)
+
+	decision, err := client.Decide(context.Background(), testInvocation())
+	if err != nil {
+		t.Fatalf("unexpected error in fail-open mode: %v", err)
+	}
+	if !decision.Degraded {
+		t.Error("expected a degraded decision once every retry has failed")
+	}
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Errorf("expected 3 attempts before falling back to fail-open, got %d", attempts)
+	}
+}
+
+func TestRetryMiddleware_DoesNotRetryNon5xxStatus(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.TimeoutMs = 2000
+	cfg.FailOpen = false
+	cfg.Middlewares = []skillgate.Middleware{skillgate.RetryMiddleware(3)}
+	client := skillgate.New(cfg)
+
+	if _, err := client.Decide(context.Background(), testInvocation()); err == nil {
+		t.Fatal("expected error for a 400 response")
+	}
+	if atomic.LoadInt32(&attempts) != 1 {
+		t.Errorf("expected a deterministic 400 not to be retried, got %d attempts", attempts)
+	}
+}
+
+func TestRetryMiddleware_GivesUpAfterMaxAttempts(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	cfg := skillgate.DefaultConfig()
+	cfg.SidecarURL = srv.URL
+	cfg.TimeoutMs = 2000
+	cfg.FailOpen = false
+	cfg.Middlewares = []skillgate.Middleware{skillgate.RetryMiddleware(2)}
+	client := skillgate.New(cfg)
+
+	_, err := client.Decide(context.Background(), testInvocation())
+	if err == nil {
+		t.Fatal("expected error once retries are exhausted")
+	}
+	if atomic.LoadInt32(&attempts) != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
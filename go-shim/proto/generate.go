@@ -0,0 +1,8 @@
+// Package skillgatepb holds the generated protobuf/gRPC bindings for the
+// SkillGateEnforcer service defined in skillgate.proto. Nothing in this
+// package is hand-written; regenerate after editing the .proto with:
+//
+//	go generate ./...
+package skillgatepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative skillgate.proto
@@ -1,4 +1,5 @@
-// Package skillgate provides a Go HTTP client for the SkillGate runtime sidecar.
+// Package skillgate provides a Go client for the SkillGate runtime sidecar,
+// over HTTP or gRPC (see Config.Transport).
 //
 // Usage:
 //
@@ -14,6 +15,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 )
 
@@ -103,12 +105,57 @@ type DecisionRecord struct {
 // EnforcerUnavailableError is returned when the sidecar is unreachable and fail_open=false.
 type EnforcerUnavailableError struct {
 	At time.Time
+
+	// Cause, when set, is the underlying transport error. ClusterClient
+	// sets this to the *ClusterError aggregating every per-endpoint
+	// failure, so callers can still inspect what each replica said.
+	Cause error
 }
 
 func (e *EnforcerUnavailableError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("skillgate: sidecar unreachable at %s (fail-closed): %s", e.At.Format(time.RFC3339), e.Cause)
+	}
 	return fmt.Sprintf("skillgate: sidecar unreachable at %s (fail-closed)", e.At.Format(time.RFC3339))
 }
 
+// Unwrap exposes Cause so errors.Is/As can reach the per-endpoint errors
+// ClusterClient aggregates into it.
+func (e *EnforcerUnavailableError) Unwrap() error {
+	return e.Cause
+}
+
+// httpStatusError is returned by post when the sidecar responds with a
+// status of 400 or above. It is distinct from a transport-level error so callers
+// (notably ClusterClient) can tell a reachable-but-unhappy sidecar apart
+// from one that never answered.
+type httpStatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("skillgate: sidecar returned %d: %s", e.StatusCode, e.Body)
+}
+
+// responseDecodeError is returned by decideOnce when the sidecar answers
+// with a 2xx status but a body that isn't a valid DecisionRecord. It is
+// distinct from a transport-level error so callers (notably retryMiddleware)
+// can tell a malformed-but-reachable response apart from one that never
+// arrived: retrying the exact same request against the exact same response
+// would just fail identically again.
+type responseDecodeError struct {
+	cause error
+}
+
+func (e *responseDecodeError) Error() string {
+	return fmt.Sprintf("skillgate: decode decision: %v", e.cause)
+}
+
+func (e *responseDecodeError) Unwrap() error {
+	return e.cause
+}
+
 // ---- Config -----------------------------------------------------------------
 
 // Config holds client configuration.
@@ -127,8 +174,57 @@ type Config struct {
 	// SLT is the Session License Token for authentication.
 	// Defaults to SKILLGATE_SLT env var.
 	SLT string
+
+	// Transport selects the wire protocol used to reach the sidecar.
+	// Defaults to TransportHTTP.
+	Transport Transport
+
+	// EvidenceVerifier, when set, is invoked automatically by Decide on
+	// every successful (non-degraded) decision; a verification failure is
+	// returned in place of the decision so a forged or unsigned ALLOW can
+	// never reach the caller. See VerifyEvidence.
+	EvidenceVerifier EvidenceVerifier
+
+	// Middlewares wraps every decide/register/health call in cross-cutting
+	// behavior (observability, retries, redaction, ...), applied uniformly
+	// regardless of Transport. Middlewares[0] is outermost. See Middleware.
+	Middlewares []Middleware
+
+	// AuditSpool, when set, durably records every invocation that Decide
+	// answers with a degraded decision (FailOpen with the sidecar down, or
+	// a Degraded=true response), so the outage isn't audit-silent. New
+	// starts a background goroutine that drains it to the sidecar once
+	// Health succeeds again. See AuditSpool and NewFileAuditSpool.
+	AuditSpool AuditSpool
+
+	// AuditDrainInterval controls how often the background drain
+	// goroutine checks sidecar health and flushes AuditSpool.
+	// Default: 10s. Unused if AuditSpool is nil.
+	AuditDrainInterval time.Duration
+
+	// DecisionCache, when set, lets Decide answer a repeated identical
+	// invocation (same Actor.ID, Tool.Name, Params and ResourceRefs) from
+	// the last known ALLOW/DENY while the sidecar is unreachable or
+	// degraded, instead of always falling back to FailOpen. The cache is
+	// only consulted when FailOpen is true. See NewDecisionCache.
+	DecisionCache *DecisionCache
+
+	// DecisionCacheTTL bounds how long a cached decision may be reused.
+	// Default: 30s. Unused if DecisionCache is nil.
+	DecisionCacheTTL time.Duration
 }
 
+// Transport selects how a Client talks to the sidecar.
+type Transport string
+
+const (
+	// TransportHTTP speaks the sidecar's REST API. This is the default.
+	TransportHTTP Transport = "http"
+	// TransportGRPC speaks the sidecar's SkillGateEnforcer gRPC service,
+	// avoiding per-call TCP+JSON overhead for latency-sensitive deployments.
+	TransportGRPC Transport = "grpc"
+)
+
 // DefaultConfig returns a Config populated from environment variables with
 // production-safe defaults.
 func DefaultConfig() Config {
@@ -141,20 +237,181 @@ func DefaultConfig() Config {
 		TimeoutMs:  defaultTimeoutMs,
 		FailOpen:   false,
 		SLT:        os.Getenv("SKILLGATE_SLT"),
+		Transport:  TransportHTTP,
 	}
 }
 
 // ---- Client -----------------------------------------------------------------
 
-// Client is a thread-safe HTTP client for the SkillGate runtime sidecar.
+// enforcer is the transport-agnostic shape shared by the HTTP and gRPC
+// implementations. Client dispatches to whichever one matches cfg.Transport.
+type enforcer interface {
+	Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error)
+	RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool
+	Health(ctx context.Context) error
+}
+
+// Client is a thread-safe client for the SkillGate runtime sidecar. It is
+// transport-agnostic: the wire protocol is chosen by cfg.Transport and
+// implemented by enforcer.
 type Client struct {
+	cfg       Config
+	impl      enforcer // transport wrapped in cfg.Middlewares
+	transport enforcer // unwrapped transport, used by the audit drain loop
+
+	mu                     sync.Mutex
+	lastPolicyVersion      string
+	lastEntitlementVersion string
+
+	stop chan struct{} // closed by Close to stop the drain goroutine
+	done chan struct{} // closed once the drain goroutine has exited
+}
+
+// New creates a new Client with the given Config. If cfg.AuditSpool is set,
+// New starts a background goroutine that periodically drains it to the
+// sidecar; callers that set AuditSpool should call Close when done with the
+// Client to stop that goroutine.
+func New(cfg Config) *Client {
+	var transport enforcer
+	if cfg.Transport == TransportGRPC {
+		transport = newGRPCEnforcer(cfg)
+	} else {
+		transport = newHTTPEnforcer(cfg)
+	}
+	return newClientWithTransport(cfg, transport)
+}
+
+// newClientWithTransport builds a Client around an already-constructed
+// transport. New uses this with a fresh httpEnforcer/grpcEnforcer; NewCluster
+// uses it with a ClusterClient, so a cluster deployment gets the same
+// cfg.Middlewares, cfg.EvidenceVerifier, and cfg.AuditSpool/cfg.DecisionCache
+// handling as a single-endpoint Client instead of having to duplicate it.
+func newClientWithTransport(cfg Config, transport enforcer) *Client {
+	c := &Client{
+		cfg:       cfg,
+		impl:      chainMiddleware(transport, cfg.Middlewares),
+		transport: transport,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if cfg.AuditSpool != nil {
+		go c.drainAuditSpool()
+	} else {
+		close(c.done)
+	}
+	return c
+}
+
+// transportCloser is implemented by transports that own background
+// goroutines or connections that must be stopped when the owning Client is
+// closed, such as ClusterClient's health-sweep loop and grpcEnforcer's
+// dialed connection. httpEnforcer has nothing to close and doesn't
+// implement it.
+type transportCloser interface {
+	Close()
+}
+
+// Close stops the background audit drain goroutine started by New, if any,
+// and closes the underlying transport if it owns background goroutines of
+// its own (see transportCloser). It does not close any in-flight requests.
+func (c *Client) Close() {
+	close(c.stop)
+	<-c.done
+	if closer, ok := c.transport.(transportCloser); ok {
+		closer.Close()
+	}
+}
+
+// Decide sends a ToolInvocation to the sidecar for an enforcement decision.
+//
+// Returns EnforcerUnavailableError if the sidecar is unreachable and FailOpen is false.
+// If cfg.EvidenceVerifier is set, it is applied to the decision before
+// Decide returns; a verification failure is surfaced in place of the
+// decision. Degraded decisions (synthesized locally during an outage) carry
+// no real evidence and are not verified.
+//
+// If cfg.AuditSpool is set, every degraded decision is durably recorded
+// before it's returned. If cfg.DecisionCache is set and FailOpen is true,
+// Decide falls back to the last known decision for an identical invocation
+// (same Actor.ID, Tool.Name, Params and ResourceRefs) when the sidecar is unreachable or
+// answers degraded, and otherwise refreshes the cache from every fresh,
+// verified decision. FailOpen=false never serves a cached decision in
+// place of EnforcerUnavailableError.
+func (c *Client) Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+	decision, err := c.impl.Decide(ctx, invocation)
+	if err != nil {
+		// Only fall back to a cached decision when the operator has opted
+		// into fail-open: FailOpen=false means hard fail-closed, and
+		// serving a stale ALLOW/DENY instead of this error would silently
+		// defeat that guarantee.
+		if c.cfg.FailOpen {
+			if cached, ok := c.cachedDecision(invocation); ok {
+				return cached, nil
+			}
+		}
+		return decision, err
+	}
+
+	if decision.Degraded {
+		returned := decision
+		// As above: only a fail-open degraded decision may be replaced by a
+		// cached one. A fail-closed degraded decision already represents a
+		// live, current answer (e.g. a real DENY from a reachable sidecar
+		// that merely flagged itself degraded) and must never be
+		// overridden by a stale cached decision.
+		if c.cfg.FailOpen {
+			if cached, ok := c.cachedDecision(invocation); ok {
+				returned = cached
+			}
+		}
+		c.spoolDegraded(invocation, returned)
+		return returned, nil
+	}
+
+	if c.cfg.EvidenceVerifier != nil {
+		if verr := c.VerifyEvidence(decision); verr != nil {
+			return DecisionRecord{}, verr
+		}
+	}
+
+	c.observeFreshDecision(invocation, decision)
+	return decision, nil
+}
+
+// VerifyEvidence checks decision.Evidence against cfg.EvidenceVerifier. It
+// is a no-op returning nil if no verifier is configured; callers that want
+// verification to be mandatory should configure Config.EvidenceVerifier so
+// Decide enforces it on every call.
+func (c *Client) VerifyEvidence(decision DecisionRecord) error {
+	if c.cfg.EvidenceVerifier == nil {
+		return nil
+	}
+	return c.cfg.EvidenceVerifier.Verify(decision)
+}
+
+// RegisterTool registers or updates a tool AI-BOM in the sidecar registry.
+// Best-effort: returns false on any connectivity failure.
+func (c *Client) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+	return c.impl.RegisterTool(ctx, toolName, metadata)
+}
+
+// Health returns nil if the sidecar is reachable and healthy.
+func (c *Client) Health(ctx context.Context) error {
+	return c.impl.Health(ctx)
+}
+
+// ---- HTTP transport ----------------------------------------------------------
+
+// httpEnforcer is the HTTP implementation of enforcer: it speaks the
+// sidecar's REST API directly.
+type httpEnforcer struct {
 	cfg        Config
 	httpClient *http.Client
 }
 
-// New creates a new Client with the given Config.
-func New(cfg Config) *Client {
-	return &Client{
+// newHTTPEnforcer creates an httpEnforcer for the given Config.
+func newHTTPEnforcer(cfg Config) *httpEnforcer {
+	return &httpEnforcer{
 		cfg: cfg,
 		httpClient: &http.Client{
 			Timeout: time.Duration(cfg.TimeoutMs) * time.Millisecond,
@@ -162,14 +419,14 @@ func New(cfg Config) *Client {
 	}
 }
 
-func (c *Client) authHeader() string {
+func (c *httpEnforcer) authHeader() string {
 	if c.cfg.SLT != "" {
 		return "Bearer " + c.cfg.SLT
 	}
 	return ""
 }
 
-func (c *Client) post(ctx context.Context, path string, body any) ([]byte, error) {
+func (c *httpEnforcer) post(ctx context.Context, path string, body any) ([]byte, error) {
 	payload, err := json.Marshal(body)
 	if err != nil {
 		return nil, fmt.Errorf("skillgate: marshal request: %w", err)
@@ -195,12 +452,12 @@ func (c *Client) post(ctx context.Context, path string, body any) ([]byte, error
 		return nil, fmt.Errorf("skillgate: read response: %w", err)
 	}
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("skillgate: sidecar returned %d: %s", resp.StatusCode, respBody)
+		return nil, &httpStatusError{StatusCode: resp.StatusCode, Body: respBody}
 	}
 	return respBody, nil
 }
 
-func (c *Client) put(ctx context.Context, path string, body any) (int, error) {
+func (c *httpEnforcer) put(ctx context.Context, path string, body any) (int, error) {
 	payload, err := json.Marshal(body)
 	if err != nil {
 		return 0, fmt.Errorf("skillgate: marshal request: %w", err)
@@ -241,7 +498,29 @@ func degradedAllow(invocationID string) DecisionRecord {
 // Decide sends a ToolInvocation to the sidecar for an enforcement decision.
 //
 // Returns EnforcerUnavailableError if the sidecar is unreachable and FailOpen is false.
-func (c *Client) Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+func (c *httpEnforcer) Decide(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
+	decision, err := c.decideOnce(ctx, invocation)
+	if err != nil {
+		if c.cfg.FailOpen {
+			return degradedAllow(invocation.InvocationID), nil
+		}
+		return DecisionRecord{}, &EnforcerUnavailableError{At: time.Now().UTC()}
+	}
+	return decision, nil
+}
+
+// failOpen reports whether c is configured to degrade to a synthesized
+// ALLOW on an unreachable sidecar, for retryMiddleware to check once its
+// retries against decideOnce are exhausted.
+func (c *httpEnforcer) failOpen() bool {
+	return c.cfg.FailOpen
+}
+
+// decideOnce performs a single, un-mediated /v1/decide round trip: no
+// fail-open/fail-closed handling, just the raw transport or decode error.
+// ClusterClient and retryMiddleware use this directly so that rotation and
+// retries happen before fail-open/fail-closed logic kicks in.
+func (c *httpEnforcer) decideOnce(ctx context.Context, invocation ToolInvocation) (DecisionRecord, error) {
 	body := map[string]any{
 		"invocation_id":   invocation.InvocationID,
 		"tool_invocation": invocation,
@@ -249,22 +528,19 @@ func (c *Client) Decide(ctx context.Context, invocation ToolInvocation) (Decisio
 
 	raw, err := c.post(ctx, "/v1/decide", body)
 	if err != nil {
-		if c.cfg.FailOpen {
-			return degradedAllow(invocation.InvocationID), nil
-		}
-		return DecisionRecord{}, &EnforcerUnavailableError{At: time.Now().UTC()}
+		return DecisionRecord{}, err
 	}
 
 	var decision DecisionRecord
 	if err := json.Unmarshal(raw, &decision); err != nil {
-		return DecisionRecord{}, fmt.Errorf("skillgate: decode decision: %w", err)
+		return DecisionRecord{}, &responseDecodeError{cause: err}
 	}
 	return decision, nil
 }
 
 // RegisterTool registers or updates a tool AI-BOM in the sidecar registry.
 // Best-effort: returns false on any connectivity failure.
-func (c *Client) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
+func (c *httpEnforcer) RegisterTool(ctx context.Context, toolName string, metadata map[string]any) bool {
 	status, err := c.put(ctx, "/v1/registry/"+toolName, metadata)
 	if err != nil {
 		return false
@@ -273,7 +549,7 @@ func (c *Client) RegisterTool(ctx context.Context, toolName string, metadata map
 }
 
 // Health returns nil if the sidecar is reachable and healthy.
-func (c *Client) Health(ctx context.Context) error {
+func (c *httpEnforcer) Health(ctx context.Context) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.cfg.SidecarURL+"/v1/health", nil)
 	if err != nil {
 		return fmt.Errorf("skillgate: build request: %w", err)
@@ -288,3 +564,11 @@ func (c *Client) Health(ctx context.Context) error {
 	}
 	return nil
 }
+
+// PostAuditBatch delivers spooled AuditRecords to the sidecar's
+// /v1/audit/batch endpoint. Delivery is at-least-once: the sidecar is
+// expected to dedup by AuditRecord.InvocationID. Implements auditPoster.
+func (c *httpEnforcer) PostAuditBatch(ctx context.Context, records []AuditRecord) error {
+	_, err := c.post(ctx, "/v1/audit/batch", map[string]any{"records": records})
+	return err
+}